@@ -0,0 +1,64 @@
+package cloudfunc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Set by the linker when the function is built by cloudfunc.
+var (
+	buildCommit  string
+	buildDirty   string
+	buildTime    string
+	buildVersion string
+	buildShim    string
+)
+
+// BuildInfo describes the build of the function binary.
+type BuildInfo struct {
+	// Commit is the VCS revision of the function source.
+	Commit string `json:"commit,omitempty"`
+	// Dirty is set if the source had uncommitted changes.
+	Dirty bool `json:"dirty,omitempty"`
+	// Time of the build in RFC 3339 format.
+	Time string `json:"time,omitempty"`
+	// Version of cloudfunc that built the function.
+	Version string `json:"version,omitempty"`
+	// Shim is the version of the runtime shim the function was built for.
+	Shim string `json:"shim,omitempty"`
+}
+
+func (b BuildInfo) String() string {
+	var parts []string
+	if b.Commit != "" {
+		c := "commit " + b.Commit
+		if b.Dirty {
+			c += " (dirty)"
+		}
+		parts = append(parts, c)
+	}
+	if b.Time != "" {
+		parts = append(parts, "built "+b.Time)
+	}
+	if b.Version != "" {
+		parts = append(parts, fmt.Sprintf("cloudfunc %s", b.Version))
+	}
+	if b.Shim != "" {
+		parts = append(parts, "shim "+b.Shim)
+	}
+	if len(parts) == 0 {
+		return "unknown build"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ReadBuildInfo returns the build information stamped into the function binary.
+func ReadBuildInfo() BuildInfo {
+	return BuildInfo{
+		Commit:  buildCommit,
+		Dirty:   buildDirty == "true",
+		Time:    buildTime,
+		Version: buildVersion,
+		Shim:    buildShim,
+	}
+}
@@ -0,0 +1,33 @@
+package cloudfunc
+
+import "context"
+
+// Claims are the claims of a caller's identity token verified by the runtime.
+type Claims struct {
+	Issuer        string   `json:"iss"`
+	Subject       string   `json:"sub"`
+	Audience      []string `json:"-"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	IssuedAt      int64    `json:"iat"`
+	ExpiresAt     int64    `json:"exp"`
+
+	// Raw contains all claims of the token.
+	Raw map[string]interface{} `json:"-"`
+}
+
+type claimsKey struct{}
+
+// WithClaims returns a copy of the context that carries verified claims.
+//
+// WithClaims is called by the runtime and should not be called by user code.
+func WithClaims(ctx context.Context, c *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, c)
+}
+
+// ClaimsFromContext returns the claims of the caller verified by the runtime.
+// It returns nil if authentication is not enabled for the function.
+func ClaimsFromContext(ctx context.Context) *Claims {
+	c, _ := ctx.Value(claimsKey{}).(*Claims)
+	return c
+}
@@ -0,0 +1,108 @@
+// Package cloudfunc provides hooks for Go packages deployed as cloud functions.
+package cloudfunc
+
+import (
+	"context"
+	"net/http"
+	"sync"
+)
+
+// InitFunc prepares the function instance before it receives any requests.
+type InitFunc func(ctx context.Context) error
+
+var (
+	initMu      sync.Mutex
+	initFuncs   []InitFunc
+	initDone    int           // number of initFuncs that succeeded
+	initRunning chan struct{} // closed when the running init function returns
+)
+
+// OnInit registers a function that is called once on cold start, before the
+// function is marked as ready. It is usually called from the package init.
+//
+// If the function returns an error, the instance reports a load failure and
+// receives no traffic.
+func OnInit(fnc InitFunc) {
+	initMu.Lock()
+	initFuncs = append(initFuncs, fnc)
+	initMu.Unlock()
+}
+
+// Init resolves secret references left in the environment (see ResolveSecrets)
+// and calls all functions registered with OnInit in the registration order.
+// Functions that succeeded are not called again by subsequent calls to Init.
+//
+// The functions are called without holding any locks. If a call of Init was
+// abandoned by the runtime after a timeout, the next call waits until the
+// function it left running returns, instead of calling it again concurrently.
+// Functions should return when ctx is done.
+//
+// Init is called by the runtime and should not be called by user code.
+func Init(ctx context.Context) error {
+	if err := ResolveSecrets(ctx); err != nil {
+		return err
+	}
+	for {
+		initMu.Lock()
+		if running := initRunning; running != nil {
+			initMu.Unlock()
+			select {
+			case <-running:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if initDone == len(initFuncs) {
+			initMu.Unlock()
+			return nil
+		}
+		fnc, done := initFuncs[initDone], make(chan struct{})
+		initRunning = done
+		initMu.Unlock()
+
+		err := fnc(ctx)
+		initMu.Lock()
+		if err == nil {
+			initDone++
+		}
+		initRunning = nil
+		close(done)
+		initMu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Middleware wraps an HTTP handler.
+type Middleware func(h http.Handler) http.Handler
+
+var (
+	middlewareMu sync.Mutex
+	middlewares  []Middleware
+)
+
+// Use registers a middleware that wraps all HTTP handlers of the function,
+// including the ones registered on http.DefaultServeMux.
+// Middlewares registered first are called first.
+//
+// Use must be called before the function starts serving, usually from the
+// package init.
+func Use(m Middleware) {
+	middlewareMu.Lock()
+	middlewares = append(middlewares, m)
+	middlewareMu.Unlock()
+}
+
+// Wrap applies all middlewares registered with Use to the handler.
+//
+// Wrap is called by the runtime and should not be called by user code.
+func Wrap(h http.Handler) http.Handler {
+	middlewareMu.Lock()
+	defer middlewareMu.Unlock()
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		h = middlewares[i](h)
+	}
+	return h
+}
@@ -0,0 +1,94 @@
+package cloudfunc
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// resetInit clears registered init functions for a test.
+func resetInit(t *testing.T, funcs ...InitFunc) {
+	initMu.Lock()
+	initFuncs, initDone, initRunning = funcs, 0, nil
+	initMu.Unlock()
+	t.Cleanup(func() {
+		initMu.Lock()
+		initFuncs, initDone, initRunning = nil, 0, nil
+		initMu.Unlock()
+	})
+}
+
+func TestInitRetry(t *testing.T) {
+	var first, second int
+	errFail := errors.New("fail")
+	resetInit(t,
+		func(ctx context.Context) error {
+			first++
+			return nil
+		},
+		func(ctx context.Context) error {
+			if second++; second == 1 {
+				return errFail
+			}
+			return nil
+		},
+	)
+	ctx := context.Background()
+	if err := Init(ctx); err != errFail {
+		t.Fatalf("expected %v, got %v", errFail, err)
+	}
+	if err := Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if first != 1 || second != 2 {
+		t.Fatalf("unexpected calls: %d, %d", first, second)
+	}
+}
+
+func TestInitAbandoned(t *testing.T) {
+	var calls, running int32
+	release := make(chan struct{})
+	resetInit(t, func(ctx context.Context) error {
+		atomic.AddInt32(&calls, 1)
+		if atomic.AddInt32(&running, 1) != 1 {
+			t.Error("init function is called concurrently")
+		}
+		defer atomic.AddInt32(&running, -1)
+		<-release
+		return nil
+	})
+
+	// the runtime abandons the first call after a timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	errc := make(chan error, 1)
+	go func() { errc <- Init(ctx) }()
+	<-ctx.Done()
+
+	// the retry times out as well while the function still runs
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+	if err := Init(ctx2); err != context.DeadlineExceeded {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+
+	// the next retry waits for the abandoned call instead of calling it again
+	done := make(chan error, 1)
+	go func() { done <- Init(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("expected one call, got %d", n)
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+type appConfig struct {
+	Env  map[string]string `yaml:"env_variables"`
+	CORS corsConfig        `yaml:"cors"`
+	Auth authConfig        `yaml:"auth"`
+}
+
+type corsConfig struct {
+	Origins []string `yaml:"allowed_origins"`
+	Methods []string `yaml:"allowed_methods"`
+	Headers []string `yaml:"allowed_headers"`
+	MaxAge  int      `yaml:"max_age"` // in seconds
+}
+
+// isSet reports if any CORS settings are set.
+func (c corsConfig) isSet() bool {
+	return len(c.Origins) != 0 || len(c.Methods) != 0 || len(c.Headers) != 0 || c.MaxAge != 0
+}
+
+// setEnv passes CORS settings to the runtime.
+func (c corsConfig) setEnv(env map[string]string) {
+	set := func(k string, v []string) {
+		if len(v) != 0 {
+			env[k] = strings.Join(v, ",")
+		}
+	}
+	set("CLOUDFUNC_CORS_ORIGINS", c.Origins)
+	set("CLOUDFUNC_CORS_METHODS", c.Methods)
+	set("CLOUDFUNC_CORS_HEADERS", c.Headers)
+	if c.MaxAge > 0 {
+		env["CLOUDFUNC_CORS_MAX_AGE"] = strconv.Itoa(c.MaxAge)
+	}
+}
+
+type authConfig struct {
+	Audience   string   `yaml:"audience"`
+	Principals []string `yaml:"allowed_principals"`
+	IAP        bool     `yaml:"iap"`
+	JWKSURL    string   `yaml:"jwks_url"`
+}
+
+// isSet reports if any authentication settings are set.
+func (c authConfig) isSet() bool {
+	return c.Audience != "" || len(c.Principals) != 0 || c.IAP || c.JWKSURL != ""
+}
+
+// setEnv passes authentication settings to the runtime. Authentication is
+// enabled by the audience, other settings without it are an error.
+func (c authConfig) setEnv(env map[string]string) error {
+	if c.Audience == "" {
+		if len(c.Principals) != 0 || c.IAP || c.JWKSURL != "" {
+			return fmt.Errorf("auth settings require an audience (auth.audience or --auth-audience)")
+		}
+		return nil
+	}
+	env["CLOUDFUNC_AUTH_AUDIENCE"] = c.Audience
+	if len(c.Principals) != 0 {
+		env["CLOUDFUNC_AUTH_PRINCIPALS"] = strings.Join(c.Principals, ",")
+	}
+	if c.IAP {
+		env["CLOUDFUNC_AUTH_IAP"] = "true"
+	}
+	if c.JWKSURL != "" {
+		env["CLOUDFUNC_AUTH_JWKS_URL"] = c.JWKSURL
+	}
+	return nil
+}
+
+func readAppConfig(path string) (*appConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var conf appConfig
+	if err := yaml.Unmarshal(data, &conf); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+// parseSize parses a size in bytes with an optional KB, MB or GB suffix.
+// The suffixes are powers of 1024.
+func parseSize(s string) (int64, error) {
+	mult := int64(1)
+	v := strings.ToUpper(strings.TrimSpace(s))
+	for i, suf := range []string{"KB", "MB", "GB"} {
+		if strings.HasSuffix(v, suf) {
+			mult = 1 << (10 * uint(i+1))
+			v = strings.TrimSpace(strings.TrimSuffix(v, suf))
+			break
+		}
+	}
+	v = strings.TrimSuffix(v, "B")
+	n, err := strconv.ParseFloat(v, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size: %q", s)
+	}
+	return int64(n * float64(mult)), nil
+}
+
+// parseKeyValues parses a list of key=value pairs.
+func parseKeyValues(list []string) (map[string]string, error) {
+	m := make(map[string]string, len(list))
+	for _, kv := range list {
+		i := strings.Index(kv, "=")
+		if i <= 0 {
+			return nil, fmt.Errorf("expected key=value, got %q", kv)
+		}
+		m[kv[:i]] = kv[i+1:]
+	}
+	return m, nil
+}
+
+// readEnvFile reads environment variables from a file with KEY=VALUE lines.
+// Empty lines and lines starting with # are ignored, values may be quoted.
+func readEnvFile(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	env := make(map[string]string)
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		j := strings.Index(line, "=")
+		if j <= 0 {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, i+1)
+		}
+		k, v := strings.TrimSpace(line[:j]), strings.TrimSpace(line[j+1:])
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			if v, err = strconv.Unquote(v); err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", path, i+1, err)
+			}
+		} else if len(v) >= 2 && v[0] == '\'' && v[len(v)-1] == '\'' {
+			v = v[1 : len(v)-1]
+		}
+		env[k] = v
+	}
+	return env, nil
+}
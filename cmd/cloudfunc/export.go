@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/nwca/cloudfunc"
+	"github.com/nwca/cloudfunc/gcp"
+	"gopkg.in/yaml.v2"
+)
+
+// exportedFunc is a manifest entry of a deployed function.
+type exportedFunc struct {
+	manifestFunc
+	// notes list settings of the function that are not in the entry.
+	notes []string
+}
+
+// exportFunc converts a deployed function to a manifest entry.
+func exportFunc(ctx context.Context, cli *gcp.Client, f *gcp.CloudFunction) *exportedFunc {
+	e := &exportedFunc{manifestFunc: manifestFunc{Name: path.Base(f.Name)}}
+	m := &e.manifestFunc
+	e.notes = append(e.notes, "package: the source package is not recorded on the function")
+	tr, err := gcp.FunctionTrigger(f)
+	switch t := tr.(type) {
+	case gcp.HTTPTrigger:
+		m.Trigger = "http"
+	case gcp.TopicTrigger:
+		m.Trigger, m.Topic = "pubsub", t.Topic
+	case gcp.StorageTrigger:
+		m.Trigger, m.Bucket = "storage", t.Bucket
+	default:
+		// fail on deploy instead of deploying an http function
+		m.Trigger = "unsupported"
+		e.notes = append(e.notes, "trigger: "+err.Error())
+	}
+	if gcp.IsNativeRuntime(f.Runtime) {
+		m.Runtime = f.Runtime
+	}
+	env, err := cli.FunctionEnv(ctx, f)
+	if err != nil {
+		e.notes = append(e.notes, "env.js: "+err.Error())
+		env = f.EnvironmentVariables
+	}
+	// variables of the runtime duplicate deploy flags and the app config
+	var runtimeEnv []string
+	for k, v := range env {
+		if strings.HasPrefix(k, "CLOUDFUNC_") {
+			runtimeEnv = append(runtimeEnv, fmt.Sprintf("env %s=%s: set by deploy flags or the app config", k, v))
+		} else {
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			m.Env[k] = v
+		}
+	}
+	sort.Strings(runtimeEnv)
+	e.notes = append(e.notes, runtimeEnv...)
+	if plain, err := gcp.CheckSecrets(m.Env); err == nil {
+		for _, k := range plain {
+			e.notes = append(e.notes, fmt.Sprintf("env %s: looks like a secret, consider a %s reference", k, cloudfunc.SecretPrefix))
+		}
+	}
+	for k, v := range f.Labels {
+		if gcp.IsMetadataLabel(k) {
+			continue
+		}
+		if m.Labels == nil {
+			m.Labels = make(map[string]string)
+		}
+		m.Labels[k] = v
+	}
+	m.Memory = int(f.AvailableMemoryMb)
+	if f.Timeout != nil {
+		m.Timeout = f.Timeout.AsDuration()
+	}
+	m.MaxInstances = int(f.MaxInstances)
+	m.VPCConnector = f.VpcConnector
+	for name, v := range gcp.IngressSettings {
+		if v == f.IngressSettings {
+			m.Ingress = name
+		}
+	}
+	e.notes = append(e.notes, gcp.UnmappedSettings(f)...)
+	return e
+}
+
+// writeManifest writes the functions as a manifest. Notes of each function are
+// written as comments above its entry.
+func writeManifest(w io.Writer, list []*exportedFunc) error {
+	if _, err := io.WriteString(w, "functions:\n"); err != nil {
+		return err
+	}
+	for _, e := range list {
+		data, err := yaml.Marshal(e.manifestFunc)
+		if err != nil {
+			return err
+		}
+		var buf strings.Builder
+		if len(e.notes) != 0 {
+			fmt.Fprintf(&buf, "  # %s: settings that are not exported:\n", e.Name)
+			for _, n := range e.notes {
+				fmt.Fprintf(&buf, "  #   %s\n", strings.Replace(n, "\n", " ", -1))
+			}
+		}
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		for i, l := range lines {
+			if i == 0 {
+				buf.WriteString("  - " + l + "\n")
+			} else {
+				buf.WriteString("    " + l + "\n")
+			}
+		}
+		if _, err = io.WriteString(w, buf.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
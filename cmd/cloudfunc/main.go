@@ -3,14 +3,20 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"github.com/nwca/cloudfunc"
 	"github.com/nwca/cloudfunc/gcp"
 	"github.com/spf13/cobra"
-	"gopkg.in/yaml.v2"
 )
 
 var Root = &cobra.Command{
@@ -18,65 +24,659 @@ var Root = &cobra.Command{
 	Short: "cloud function utility for Go",
 }
 
+// exitCode is the exit code of a command that succeeded.
+var exitCode int
+
 func init() {
 	const (
-		projectFlag   = "project"
-		appConfigFlag = "app-config"
+		projectFlag     = "project"
+		credsFlag       = "credentials"
+		impersonateFlag = "impersonate-service-account"
+		tokenFlag       = "access-token"
+		verboseFlag     = "verbose"
+		appConfigFlag   = "app-config"
+		initTimeoutFlag = "init-timeout"
+		initRetriesFlag = "init-retries"
+		corsOriginFlag  = "cors-origin"
+		corsMethodFlag  = "cors-method"
+		corsHeaderFlag  = "cors-header"
+		corsMaxAgeFlag  = "cors-max-age"
+		authAudFlag     = "auth-audience"
+		authPrincFlag   = "auth-principal"
+		authIAPFlag     = "auth-iap"
+		authJWKSFlag    = "auth-jwks-url"
+		forceFlag       = "force"
+		cacheDirFlag    = "cache-dir"
+		buildTagsFlag   = "build-tags"
+		ldflagsFlag     = "ldflags"
+		buildFlagFlag   = "build-flag"
+		includeFlag     = "include"
+		stripFlag       = "strip"
+		compressFlag    = "compression"
+		sizeBudgetFlag  = "size-budget"
+		smokeFlag       = "smoke"
+		shimFlag        = "shim"
+		runtimeFlag     = "runtime"
+		asyncFlag       = "async"
+		stagingFlag     = "staging-bucket"
+		stagingLocFlag  = "staging-location"
+		keepFlag        = "keep"
+		toFlag          = "to"
+		labelFlag       = "label"
+		vpcFlag         = "vpc-connector"
+		ingressFlag     = "ingress"
+		maxInstFlag     = "max-instances"
+		setEnvFlag      = "set-env"
+		unsetEnvFlag    = "unset-env"
+		envFileFlag     = "env-file"
+		envJSFlag       = "env-js"
+		addrFlag        = "addr"
+		secretsDirFlag  = "secrets-dir"
+		resolverFlag    = "secret-resolver"
+		allowUnauthFlag = "allow-unauthenticated"
+		memoryFlag      = "memory"
+		timeoutFlag     = "timeout"
+		fileFlag        = "file"
+		parallelFlag    = "parallel"
+		failFastFlag    = "fail-fast"
+		outputFlag      = "output"
+		noMetadataFlag  = "no-metadata-labels"
 	)
 	Root.PersistentFlags().StringP(projectFlag, "p", "", "project to use")
+	Root.PersistentFlags().String(credsFlag, "", "credentials file to use instead of application default credentials")
+	Root.PersistentFlags().String(impersonateFlag, "", "service account to impersonate")
+	Root.PersistentFlags().String(tokenFlag, "", "OAuth2 access token to use instead of application default credentials")
+	Root.PersistentFlags().BoolP(verboseFlag, "v", false, "print the active identity")
 
-	getDeployParams := func(cmd *cobra.Command) (cli *gcp.Client, env map[string]string, _ error) {
-		proj, _ := cmd.Flags().GetString(projectFlag)
-		if proj == "" {
-			return nil, nil, fmt.Errorf("project not specified")
+	// newClient creates a client with credentials set by flags.
+	newClient := func(cmd *cobra.Command, proj string) (*gcp.Client, error) {
+		var opts []gcp.ClientOption
+		if v, _ := cmd.Flags().GetString(credsFlag); v != "" {
+			opts = append(opts, gcp.WithCredentialsFile(v))
 		}
-		if c, _ := cmd.Flags().GetString(appConfigFlag); c != "" {
-			data, err := ioutil.ReadFile(c)
+		if v, _ := cmd.Flags().GetString(tokenFlag); v != "" {
+			opts = append(opts, gcp.WithAccessToken(v))
+		}
+		if v, _ := cmd.Flags().GetString(impersonateFlag); v != "" {
+			opts = append(opts, gcp.WithImpersonation(v))
+		}
+		cli, err := gcp.NewClient(proj, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if verbose, _ := cmd.Flags().GetBool(verboseFlag); verbose {
+			account, source, err := cli.Identity(context.Background())
 			if err != nil {
-				return nil, nil, err
+				log.Printf("cannot determine identity (%s): %v", source, err)
+			} else if account == "" {
+				log.Printf("using %s", source)
+			} else {
+				log.Printf("using %s (%s)", account, source)
 			}
+		}
+		return cli, nil
+	}
+
+	// getAppConfig reads the app config set by flags.
+	getAppConfig := func(cmd *cobra.Command) (*appConfig, error) {
+		if c, _ := cmd.Flags().GetString(appConfigFlag); c != "" {
+			return readAppConfig(c)
+		}
+		return &appConfig{}, nil
+	}
 
-			var conf struct {
-				Env map[string]string `yaml:"env_variables"`
+	// getHTTPEnv returns variables for CORS and auth settings of the app config
+	// and flags. The settings are handled by the runtime for http functions only,
+	// so they are an error for other triggers.
+	getHTTPEnv := func(cmd *cobra.Command, conf *appConfig, tr gcp.Trigger) (map[string]string, error) {
+		if _, ok := tr.(gcp.HTTPTrigger); !ok {
+			if conf.CORS.isSet() || conf.Auth.isSet() {
+				return nil, fmt.Errorf("cors and auth settings of the app config are only supported by http functions")
 			}
+			return nil, nil
+		}
+		env := make(map[string]string)
+		cors := conf.CORS
+		if v, _ := cmd.Flags().GetStringSlice(corsOriginFlag); len(v) != 0 {
+			cors.Origins = v
+		}
+		if v, _ := cmd.Flags().GetStringSlice(corsMethodFlag); len(v) != 0 {
+			cors.Methods = v
+		}
+		if v, _ := cmd.Flags().GetStringSlice(corsHeaderFlag); len(v) != 0 {
+			cors.Headers = v
+		}
+		if d, _ := cmd.Flags().GetDuration(corsMaxAgeFlag); d > 0 {
+			cors.MaxAge = int(d / time.Second)
+		}
+		cors.setEnv(env)
+		auth := conf.Auth
+		if v, _ := cmd.Flags().GetString(authAudFlag); v != "" {
+			auth.Audience = v
+		}
+		if v, _ := cmd.Flags().GetStringSlice(authPrincFlag); len(v) != 0 {
+			auth.Principals = v
+		}
+		if v, _ := cmd.Flags().GetBool(authIAPFlag); v {
+			auth.IAP = v
+		}
+		if v, _ := cmd.Flags().GetString(authJWKSFlag); v != "" {
+			auth.JWKSURL = v
+		}
+		if err := auth.setEnv(env); err != nil {
+			return nil, err
+		}
+		return env, nil
+	}
 
-			if err := yaml.Unmarshal(data, &conf); err != nil {
-				return nil, nil, err
+	// getBuildParams returns build options for a function with a given trigger.
+	// If the trigger is nil, settings of http functions are not set; the caller
+	// sets them for each function with getHTTPEnv.
+	getBuildParams := func(cmd *cobra.Command, tr gcp.Trigger) (*gcp.BuildOptions, error) {
+		conf, err := getAppConfig(cmd)
+		if err != nil {
+			return nil, err
+		}
+		// app config < env file < --set-env, then --unset-env
+		env := make(map[string]string)
+		for k, v := range conf.Env {
+			env[k] = v
+		}
+		if p, _ := cmd.Flags().GetString(envFileFlag); p != "" {
+			fenv, err := readEnvFile(p)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range fenv {
+				env[k] = v
+			}
+		}
+		set, _ := cmd.Flags().GetStringArray(setEnvFlag)
+		senv, err := parseKeyValues(set)
+		if err != nil {
+			return nil, fmt.Errorf("invalid env variable: %v", err)
+		}
+		for k, v := range senv {
+			env[k] = v
+		}
+		unset, _ := cmd.Flags().GetStringSlice(unsetEnvFlag)
+		for _, k := range unset {
+			delete(env, k)
+		}
+		if tr != nil {
+			henv, err := getHTTPEnv(cmd, conf, tr)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range henv {
+				env[k] = v
+			}
+		}
+		if d, _ := cmd.Flags().GetDuration(initTimeoutFlag); d > 0 {
+			env["CLOUDFUNC_INIT_TIMEOUT"] = d.String()
+		}
+		if n, _ := cmd.Flags().GetInt(initRetriesFlag); n > 0 {
+			env["CLOUDFUNC_INIT_RETRIES"] = strconv.Itoa(n)
+		}
+		plain, err := gcp.CheckSecrets(env)
+		if err != nil {
+			return nil, fmt.Errorf("invalid env variable %v", err)
+		}
+		for _, k := range plain {
+			log.Printf("warning: %s looks like a secret; consider a %s reference", k, cloudfunc.SecretPrefix)
+		}
+		opt := &gcp.BuildOptions{Env: env}
+		opt.EnvJS, _ = cmd.Flags().GetBool(envJSFlag)
+		opt.Tags, _ = cmd.Flags().GetStringSlice(buildTagsFlag)
+		opt.LDFlags, _ = cmd.Flags().GetString(ldflagsFlag)
+		opt.Flags, _ = cmd.Flags().GetStringArray(buildFlagFlag)
+		incs, _ := cmd.Flags().GetStringArray(includeFlag)
+		for _, s := range incs {
+			inc, err := gcp.ParseInclude(s)
+			if err != nil {
+				return nil, err
+			}
+			opt.Include = append(opt.Include, inc)
+		}
+		opt.Strip, _ = cmd.Flags().GetBool(stripFlag)
+		opt.Smoke, _ = cmd.Flags().GetBool(smokeFlag)
+		opt.Runtime, _ = cmd.Flags().GetString(runtimeFlag)
+		opt.SecretResolver, _ = cmd.Flags().GetString(resolverFlag)
+		if p, _ := cmd.Flags().GetString(shimFlag); p != "" {
+			sh, err := gcp.LoadShim(p)
+			if err != nil {
+				return nil, err
 			}
-			env = conf.Env
+			opt.Shim = sh
+		}
+		opt.Compression, _ = cmd.Flags().GetInt(compressFlag)
+		if v, _ := cmd.Flags().GetString(sizeBudgetFlag); v != "" {
+			n, err := parseSize(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid size budget: %v", err)
+			}
+			opt.SizeBudget = n
+		}
+		return opt, nil
+	}
+
+	getDeployParams := func(cmd *cobra.Command, tr gcp.Trigger) (*gcp.Client, *gcp.BuildOptions, error) {
+		proj, _ := cmd.Flags().GetString(projectFlag)
+		if proj == "" {
+			return nil, nil, fmt.Errorf("project not specified")
+		}
+		opt, err := getBuildParams(cmd, tr)
+		if err != nil {
+			return nil, nil, err
 		}
-		cli, err := gcp.NewClient(proj)
+		cli, err := newClient(cmd, proj)
 		if err != nil {
 			return nil, nil, err
 		}
-		return cli, env, nil
+		return cli, opt, nil
+	}
+
+	// addTriggerCmds adds a subcommand for each trigger type to the parent command.
+	addTriggerCmds := func(parent *cobra.Command, verb string, run func(cmd *cobra.Command, name string, tr gcp.Trigger) error) {
+		parent.PersistentFlags().StringP(appConfigFlag, "c", "", "app config to use")
+		parent.PersistentFlags().Duration(initTimeoutFlag, 0, "timeout for init functions on cold start")
+		parent.PersistentFlags().Int(initRetriesFlag, 0, "number of retries for failed init functions")
+		parent.PersistentFlags().StringArray(setEnvFlag, nil, "environment variable as KEY=VALUE; overrides the app config and env file")
+		parent.PersistentFlags().StringSlice(unsetEnvFlag, nil, "environment variable to remove")
+		parent.PersistentFlags().String(envFileFlag, "", "file with KEY=VALUE environment variables; overrides the app config")
+		parent.PersistentFlags().Bool(envJSFlag, false, "write environment variables to env.js in the archive instead of setting them on the function")
+		parent.PersistentFlags().StringSlice(buildTagsFlag, nil, "additional build tags")
+		parent.PersistentFlags().String(ldflagsFlag, "", "flags passed to the Go linker")
+		parent.PersistentFlags().StringArray(buildFlagFlag, nil, "additional flag passed to go build")
+		parent.PersistentFlags().StringArray(includeFlag, nil, "files to add to the archive, as pattern[:prefix]")
+		parent.PersistentFlags().Bool(stripFlag, false, "strip symbols and debug information from the binary")
+		parent.PersistentFlags().Int(compressFlag, 0, "archive compression level from 1 to 9 (default level if not set)")
+		parent.PersistentFlags().String(sizeBudgetFlag, "", "fail if the compressed archive is larger, e.g. 20MB")
+		parent.PersistentFlags().Bool(smokeFlag, false, "run the function locally and send a test request before packaging")
+		parent.PersistentFlags().String(shimFlag, "", "directory or tar archive with a custom runtime shim")
+		parent.PersistentFlags().String(runtimeFlag, "", "function runtime; native Go runtimes (e.g. go113) are built by the platform without the shim")
+		parent.PersistentFlags().String(resolverFlag, "", "package that registers a secret resolver in its init")
+
+		httpCmd := &cobra.Command{
+			Use:   "http",
+			Short: verb + " http trigger",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("expected 2 arguments: function name and package name")
+				}
+				name, pkg := args[0], args[1]
+				t, err := gcp.ParseTarget(pkg)
+				if err != nil {
+					return err
+				}
+
+				return run(cmd, name, gcp.HTTPTrigger{Target: t})
+			},
+		}
+		httpCmd.Flags().StringSlice(corsOriginFlag, nil, "origins allowed by CORS, or * for any origin")
+		httpCmd.Flags().StringSlice(corsMethodFlag, nil, "methods allowed by CORS")
+		httpCmd.Flags().StringSlice(corsHeaderFlag, nil, "request headers allowed by CORS")
+		httpCmd.Flags().Duration(corsMaxAgeFlag, 0, "how long CORS preflight results can be cached")
+		httpCmd.Flags().String(authAudFlag, "", "require ID tokens issued for this audience")
+		httpCmd.Flags().StringSlice(authPrincFlag, nil, "principals allowed to call the function")
+		httpCmd.Flags().Bool(authIAPFlag, false, "verify IAP JWT assertions instead of ID tokens")
+		httpCmd.Flags().String(authJWKSFlag, "", "URL of the key set used to verify tokens")
+		parent.AddCommand(httpCmd)
+
+		pubsubCmd := &cobra.Command{
+			Use:   "pubsub",
+			Short: verb + " pubsub trigger",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("expected 2 arguments: function name and package name")
+				}
+				topic, _ := cmd.Flags().GetString("topic")
+				if topic == "" {
+					return fmt.Errorf("topic not specified")
+				}
+				name, pkg := args[0], args[1]
+				t, err := gcp.ParseTarget(pkg)
+				if err != nil {
+					return err
+				}
+
+				return run(cmd, name, gcp.TopicTrigger{Target: t, Topic: topic})
+			},
+		}
+		pubsubCmd.Flags().StringP("topic", "t", "", "topic id")
+		parent.AddCommand(pubsubCmd)
+
+		storageCmd := &cobra.Command{
+			Use:   "storage",
+			Short: verb + " storage trigger",
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) != 2 {
+					return fmt.Errorf("expected 2 arguments: function name and package name")
+				}
+				bucket, _ := cmd.Flags().GetString("bucket")
+				if bucket == "" {
+					return fmt.Errorf("topic not specified")
+				}
+				event, _ := cmd.Flags().GetString("event")
+				if event != "" && !strings.HasPrefix(event, gcp.StorageEventPref) {
+					event = gcp.StorageEventPref + event
+				}
+				name, pkg := args[0], args[1]
+				t, err := gcp.ParseTarget(pkg)
+				if err != nil {
+					return err
+				}
+				return run(cmd, name, gcp.StorageTrigger{
+					Target: t, Bucket: bucket,
+					//Event: gcp.StorageEvent(event),
+				})
+			},
+		}
+		storageCmd.Flags().StringP("bucket", "b", "", "bucket name")
+		//storageCmd.Flags().StringP("event", "e", "", "event type")
+		parent.AddCommand(storageCmd)
+	}
+
+	buildCmd := &cobra.Command{
+		Use:   "build",
+		Short: "build cloud function archive",
+	}
+	buildCmd.PersistentFlags().StringP(outputFlag, "o", "", "output file (default is <name>.zip)")
+	Root.AddCommand(buildCmd)
+
+	addTriggerCmds(buildCmd, "build", func(cmd *cobra.Command, name string, tr gcp.Trigger) error {
+		opt, err := getBuildParams(cmd, tr)
+		if err != nil {
+			return err
+		}
+		out, _ := cmd.Flags().GetString(outputFlag)
+		if out == "" {
+			out = name + ".zip"
+		}
+		// write to a temporary file, so failed builds, e.g. the ones over
+		// the size budget, don't leave an archive behind
+		f, err := ioutil.TempFile(filepath.Dir(out), filepath.Base(out)+"-*.tmp")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		defer f.Close()
+		if err = gcp.Build(tr, opt, f); err != nil {
+			return err
+		}
+		if err = f.Chmod(0644); err != nil {
+			return err
+		}
+		if err = f.Close(); err != nil {
+			return err
+		}
+		if err = os.Rename(f.Name(), out); err != nil {
+			return err
+		}
+		log.Println("function archive written to", out)
+		if !opt.EnvJS && len(opt.Env) != 0 {
+			log.Println("environment variables are not in the archive; they are set by 'cloudfunc deploy zip', or use --env-js")
+		}
+		return nil
+	})
+
+	serveCmd := &cobra.Command{
+		Use:   "serve",
+		Short: "run cloud function locally",
+	}
+	serveCmd.PersistentFlags().String(addrFlag, ":8080", "host and port to listen on")
+	serveCmd.PersistentFlags().String(secretsDirFlag, "", "directory with files named after referenced secrets, instead of Secret Manager")
+	Root.AddCommand(serveCmd)
+
+	addTriggerCmds(serveCmd, "serve", func(cmd *cobra.Command, name string, tr gcp.Trigger) error {
+		opt, err := getBuildParams(cmd, tr)
+		if err != nil {
+			return err
+		}
+		sopt := &gcp.ServeOptions{}
+		sopt.Addr, _ = cmd.Flags().GetString(addrFlag)
+		sopt.SecretsDir, _ = cmd.Flags().GetString(secretsDirFlag)
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		return gcp.Serve(ctx, tr, opt, sopt)
+	})
+
+	// addSettingsFlags adds flags for function settings set by deploy.
+	addSettingsFlags := func(cmd *cobra.Command) {
+		cmd.PersistentFlags().StringArray(labelFlag, nil, "function label as key=value; replaces existing labels if set")
+		cmd.PersistentFlags().String(vpcFlag, "", "Serverless VPC Access connector for the function")
+		cmd.PersistentFlags().String(ingressFlag, "", "allowed ingress traffic: all, internal-only or internal-and-gclb")
+		cmd.PersistentFlags().Int(maxInstFlag, 0, "maximum number of function instances")
+		cmd.PersistentFlags().Int(memoryFlag, 0, "memory available to the function, in MB")
+		cmd.PersistentFlags().Duration(timeoutFlag, 0, "function execution timeout")
+	}
+	// getSettings sets function settings on the deploy options.
+	getSettings := func(cmd *cobra.Command, opt *gcp.DeployOptions) error {
+		if cmd.Flags().Changed(labelFlag) {
+			list, _ := cmd.Flags().GetStringArray(labelFlag)
+			labels, err := parseKeyValues(list)
+			if err != nil {
+				return fmt.Errorf("invalid label: %v", err)
+			}
+			opt.Labels = labels
+		}
+		opt.VPCConnector, _ = cmd.Flags().GetString(vpcFlag)
+		opt.Ingress, _ = cmd.Flags().GetString(ingressFlag)
+		opt.MaxInstances, _ = cmd.Flags().GetInt(maxInstFlag)
+		opt.Memory, _ = cmd.Flags().GetInt(memoryFlag)
+		opt.Timeout, _ = cmd.Flags().GetDuration(timeoutFlag)
+		return nil
 	}
 
 	deployCmd := &cobra.Command{
 		Use:   "deploy",
 		Short: "deploy cloud function",
 	}
-	deployCmd.PersistentFlags().StringP(appConfigFlag, "c", "", "app config to use")
+	deployCmd.PersistentFlags().Bool(forceFlag, false, "deploy even if the function has not changed")
+	deployCmd.PersistentFlags().String(cacheDirFlag, "", "directory for cached function archives")
+	deployCmd.PersistentFlags().Bool(asyncFlag, false, "print the operation name instead of waiting for it")
+	deployCmd.PersistentFlags().String(stagingFlag, "", "upload the archive to this bucket instead of a URL provided by the API")
+	deployCmd.PersistentFlags().String(stagingLocFlag, "", "location of the staging bucket, if it needs to be created (default is the function region)")
+	deployCmd.PersistentFlags().Int(keepFlag, 0, "keep this many archives of the function in the staging bucket for rollback")
+	addSettingsFlags(deployCmd)
+	deployCmd.PersistentFlags().Bool(noMetadataFlag, false, "don't label the function with the cloudfunc version, commit, branch and deployer")
+	deployCmd.PersistentFlags().Bool(allowUnauthFlag, false, "allow anyone to call the http function")
 	Root.AddCommand(deployCmd)
 
-	deployTrigger := func(cmd *cobra.Command, name string, tr gcp.Trigger) error {
-		ctx := context.Background()
-		cli, env, err := getDeployParams(cmd)
-		if err != nil {
+	// allowUnauth reports if the function should allow unauthenticated calls
+	// after the deploy.
+	allowUnauth := func(cmd *cobra.Command, tr gcp.Trigger) (bool, error) {
+		if allow, _ := cmd.Flags().GetBool(allowUnauthFlag); !allow {
+			return false, nil
+		}
+		if _, ok := tr.(gcp.HTTPTrigger); !ok {
+			return false, fmt.Errorf("--%s is only supported for http functions", allowUnauthFlag)
+		}
+		if async, _ := cmd.Flags().GetBool(asyncFlag); async {
+			return false, fmt.Errorf("--%s cannot be used with --%s", allowUnauthFlag, asyncFlag)
+		}
+		return true, nil
+	}
+	grantAllUsers := func(ctx context.Context, cli *gcp.Client, name string) error {
+		if _, err := cli.AddInvoker(ctx, name, gcp.AllUsers); err != nil {
 			return err
 		}
-		defer cli.Close()
+		log.Println("function", name, "allows unauthenticated calls")
+		return nil
+	}
 
-		file, err := gcp.BuildTmp(tr, env)
+	// deployArchive deploys the archive and waits for the operation, unless
+	// the async flag is set. An interrupt cancels the deploy.
+	deployArchive := func(ctx context.Context, cmd *cobra.Command, cli *gcp.Client, name string, tr gcp.Trigger, r io.Reader, opt *gcp.DeployOptions) error {
+		ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+		if opt.Progress == nil {
+			opt.Progress = printProgress("")
+		}
+		opt.StagingBucket, _ = cmd.Flags().GetString(stagingFlag)
+		opt.StagingLocation, _ = cmd.Flags().GetString(stagingLocFlag)
+		opt.Keep, _ = cmd.Flags().GetInt(keepFlag)
+		public, err := allowUnauth(cmd, tr)
 		if err != nil {
 			return err
 		}
+		if async, _ := cmd.Flags().GetBool(asyncFlag); async {
+			op, err := cli.StartDeploy(ctx, name, tr, r, opt)
+			if err != nil {
+				return err
+			}
+			log.Println("deploy started, use 'cloudfunc wait' to wait for it")
+			fmt.Println(op)
+			return nil
+		}
+		if err = cli.Deploy(ctx, name, tr, r, opt); err != nil || !public {
+			return err
+		}
+		return grantAllUsers(ctx, cli, name)
+	}
+
+	// deployFunc builds and deploys the function with settings from dopt,
+	// unless it has not changed. It returns the status for the summary of
+	// multi-function deploys.
+	deployFunc := func(ctx context.Context, cmd *cobra.Command, cli *gcp.Client, name string, tr gcp.Trigger, opt *gcp.BuildOptions, dopt *gcp.DeployOptions) (string, error) {
+		public, err := allowUnauth(cmd, tr)
+		if err != nil {
+			return "", err
+		}
+		hash, err := gcp.BuildHash(tr, opt)
+		if err != nil {
+			return "", fmt.Errorf("cannot hash build inputs: %v", err)
+		}
+		dopt.Hash, dopt.Runtime, dopt.Commit = hash, opt.Runtime, gcp.SourceCommit(tr)
+		dopt.Branch = gcp.SourceBranch(tr)
+		dopt.NoMetadata, _ = cmd.Flags().GetBool(noMetadataFlag)
+		dopt.Env = gcp.DeployEnv(opt)
+		if force, _ := cmd.Flags().GetBool(forceFlag); !force {
+			plan, err := cli.Plan(ctx, name, tr, dopt)
+			if err != nil {
+				return "", err
+			} else if !plan.Pending() {
+				log.Println("no changes in function", name)
+				if public {
+					err = grantAllUsers(ctx, cli, name)
+				}
+				return statusUnchanged, err
+			}
+		}
+		cacheDir, _ := cmd.Flags().GetString(cacheDirFlag)
+		if cacheDir == "" {
+			cacheDir, err = gcp.DefaultCacheDir()
+			if err != nil {
+				return "", err
+			}
+		}
+
+		log.Println("building function", name)
+		file, err := gcp.BuildCached(tr, opt, hash, cacheDir)
+		if err != nil {
+			return "", err
+		}
 		defer file.Close()
 
 		log.Println("deploying function", name)
-		return cli.Deploy(ctx, name, tr, file)
+		if err = deployArchive(ctx, cmd, cli, name, tr, file, dopt); err != nil {
+			return "", err
+		}
+		if async, _ := cmd.Flags().GetBool(asyncFlag); async {
+			return statusStarted, nil
+		}
+		return statusDeployed, nil
 	}
 
+	addTriggerCmds(deployCmd, "deploy", func(cmd *cobra.Command, name string, tr gcp.Trigger) error {
+		if _, err := allowUnauth(cmd, tr); err != nil {
+			return err
+		}
+		cli, opt, err := getDeployParams(cmd, tr)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+		dopt := &gcp.DeployOptions{}
+		if err = getSettings(cmd, dopt); err != nil {
+			return err
+		}
+		_, err = deployFunc(context.Background(), cmd, cli, name, tr, opt, dopt)
+		return err
+	})
+
+	deployManifest := &cobra.Command{
+		Use:   "manifest",
+		Short: "deploy functions listed in a manifest; all of them unless names are passed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, _ := cmd.Flags().GetString(fileFlag)
+			m, err := readManifest(path)
+			if err != nil {
+				return err
+			}
+			list, err := m.selected(args)
+			if err != nil {
+				return err
+			}
+			n, _ := cmd.Flags().GetInt(parallelFlag)
+			if n < 1 {
+				return fmt.Errorf("invalid number of parallel deploys: %d", n)
+			}
+			failFast, _ := cmd.Flags().GetBool(failFastFlag)
+			conf, err := getAppConfig(cmd)
+			if err != nil {
+				return err
+			}
+			cli, base, err := getDeployParams(cmd, nil)
+			if err != nil {
+				return err
+			}
+			defer cli.Close()
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			names := make([]string, len(list))
+			for i, f := range list {
+				names[i] = f.Name
+			}
+			res := runParallel(ctx, names, n, failFast, func(ctx context.Context, i int) (string, error) {
+				f := list[i]
+				tr, err := f.trigger(m.dir)
+				if err != nil {
+					return "", err
+				}
+				henv, err := getHTTPEnv(cmd, conf, tr)
+				if err != nil {
+					return "", err
+				}
+				dopt := &gcp.DeployOptions{}
+				if err = getSettings(cmd, dopt); err != nil {
+					return "", err
+				}
+				f.setOn(dopt)
+				if n > 1 {
+					dopt.Progress = printProgress(f.Name + ": ")
+				}
+				opt := f.buildOptions(base)
+				for k, v := range henv {
+					opt.Env[k] = v
+				}
+				return deployFunc(ctx, cmd, cli, f.Name, tr, opt, dopt)
+			})
+			if err = printSummary(os.Stdout, res); err != nil {
+				return err
+			}
+			if failed := countFailed(res); failed != 0 {
+				return fmt.Errorf("%d of %d functions were not deployed", failed, len(res))
+			}
+			return nil
+		},
+	}
+	deployManifest.Flags().StringP(fileFlag, "f", "cloudfunc.yaml", "manifest file")
+	deployManifest.Flags().Int(parallelFlag, 1, "number of functions to build and deploy at the same time")
+	deployManifest.Flags().Bool(failFastFlag, false, "stop after the first failure")
+	deployCmd.AddCommand(deployManifest)
+
 	deployZip := &cobra.Command{
 		Use:   "zip",
 		Short: "deploy zip file",
@@ -93,87 +693,258 @@ func init() {
 			}
 			defer f.Close()
 
-			cli, _, err := getDeployParams(cmd)
+			tr := gcp.HTTPTrigger{}
+			cli, opt, err := getDeployParams(cmd, tr)
 			if err != nil {
 				return err
 			}
 			defer cli.Close()
+			if err = gcp.CheckArchiveFile(f, opt.SizeBudget); err != nil {
+				return err
+			}
 
-			return cli.Deploy(ctx, name, gcp.HTTPTrigger{}, f)
+			dopt := &gcp.DeployOptions{Runtime: opt.Runtime, Env: gcp.DeployEnv(opt)}
+			if err = getSettings(cmd, dopt); err != nil {
+				return err
+			}
+			return deployArchive(ctx, cmd, cli, name, tr, f, dopt)
 		},
 	}
 	deployCmd.AddCommand(deployZip)
 
-	deployHttp := &cobra.Command{
-		Use:   "http",
-		Short: "deploy http trigger",
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "show what deploy would change; exits with code 2 if changes are pending",
+	}
+	planCmd.PersistentFlags().String(cacheDirFlag, "", "directory for cached function archives")
+	addSettingsFlags(planCmd)
+	Root.AddCommand(planCmd)
+
+	addTriggerCmds(planCmd, "plan", func(cmd *cobra.Command, name string, tr gcp.Trigger) error {
+		cli, opt, err := getDeployParams(cmd, tr)
+		if err != nil {
+			return err
+		}
+		defer cli.Close()
+
+		hash, err := gcp.BuildHash(tr, opt)
+		if err != nil {
+			return fmt.Errorf("cannot hash build inputs: %v", err)
+		}
+		cacheDir, _ := cmd.Flags().GetString(cacheDirFlag)
+		if cacheDir == "" {
+			cacheDir, err = gcp.DefaultCacheDir()
+			if err != nil {
+				return err
+			}
+		}
+		log.Println("building function", name)
+		file, err := gcp.BuildCached(tr, opt, hash, cacheDir)
+		if err != nil {
+			return err
+		}
+		file.Close()
+
+		dopt := &gcp.DeployOptions{Hash: hash, Runtime: opt.Runtime, Env: gcp.DeployEnv(opt)}
+		if err = getSettings(cmd, dopt); err != nil {
+			return err
+		}
+		plan, err := cli.Plan(context.Background(), name, tr, dopt)
+		if err != nil {
+			return err
+		}
+		if err = plan.Print(os.Stdout); err != nil {
+			return err
+		}
+		if plan.Pending() {
+			exitCode = 2
+		}
+		return nil
+	})
+
+	waitCmd := &cobra.Command{
+		Use:   "wait",
+		Short: "wait for a deploy operation",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 2 {
-				return fmt.Errorf("expected 2 arguments: function name and package name")
+			if len(args) != 1 {
+				return fmt.Errorf("expected operation name")
 			}
-			name, pkg := args[0], args[1]
-			t, err := gcp.ParseTarget(pkg)
+			proj, _ := cmd.Flags().GetString(projectFlag)
+			if proj == "" {
+				return fmt.Errorf("project not specified")
+			}
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			cli, err := newClient(cmd, proj)
 			if err != nil {
 				return err
 			}
-
-			return deployTrigger(cmd, name, gcp.HTTPTrigger{Target: t})
+			defer cli.Close()
+			return cli.Wait(ctx, args[0], printProgress(""))
 		},
 	}
-	deployCmd.AddCommand(deployHttp)
+	Root.AddCommand(waitCmd)
+
+	// historyClient returns a client and the staging bucket for history commands.
+	historyClient := func(cmd *cobra.Command) (*gcp.Client, string, error) {
+		proj, _ := cmd.Flags().GetString(projectFlag)
+		if proj == "" {
+			return nil, "", fmt.Errorf("project not specified")
+		}
+		bucket, _ := cmd.Flags().GetString(stagingFlag)
+		if bucket == "" {
+			return nil, "", fmt.Errorf("staging bucket not specified")
+		}
+		cli, err := newClient(cmd, proj)
+		if err != nil {
+			return nil, "", err
+		}
+		return cli, bucket, nil
+	}
 
-	deployPubSub := &cobra.Command{
-		Use:   "pubsub",
-		Short: "deploy pubsub trigger",
+	historyCmd := &cobra.Command{
+		Use:   "history",
+		Short: "list archives of a function kept for rollback",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 2 {
-				return fmt.Errorf("expected 2 arguments: function name and package name")
+			if len(args) != 1 {
+				return fmt.Errorf("expected function name")
 			}
-			topic, _ := cmd.Flags().GetString("topic")
-			if topic == "" {
-				return fmt.Errorf("topic not specified")
+			cli, bucket, err := historyClient(cmd)
+			if err != nil {
+				return err
 			}
-			name, pkg := args[0], args[1]
-			t, err := gcp.ParseTarget(pkg)
+			defer cli.Close()
+			list, err := cli.History(context.Background(), args[0], bucket)
 			if err != nil {
 				return err
 			}
+			for _, e := range list {
+				cur := " "
+				if e.Current {
+					cur = "*"
+				}
+				commit := e.Commit
+				if commit == "" {
+					commit = "-"
+				}
+				fmt.Printf("%s %s  %s  %-12.12s  %s  %s\n", cur, e.ID,
+					e.Deployed.Local().Format("2006-01-02 15:04:05"), e.Hash, commit, gcp.FormatSize(e.Size))
+			}
+			return nil
+		},
+	}
+	historyCmd.Flags().String(stagingFlag, "", "staging bucket with the kept archives")
+	Root.AddCommand(historyCmd)
 
-			return deployTrigger(cmd, name, gcp.TopicTrigger{Target: t, Topic: topic})
+	rollbackCmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "redeploy a previous archive of a function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("expected function name")
+			}
+			name := args[0]
+			cli, bucket, err := historyClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cli.Close()
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+			defer stop()
+			id, _ := cmd.Flags().GetString(toFlag)
+			log.Println("rolling back function", name)
+			return cli.Rollback(ctx, name, bucket, id, &gcp.DeployOptions{Progress: printProgress("")})
 		},
 	}
-	deployPubSub.Flags().StringP("topic", "t", "", "topic id")
-	deployCmd.AddCommand(deployPubSub)
+	rollbackCmd.Flags().String(stagingFlag, "", "staging bucket with the kept archives")
+	rollbackCmd.Flags().String(toFlag, "", "ID of the archive to deploy (default is the one before the current)")
+	Root.AddCommand(rollbackCmd)
+
+	// iamClient returns a client and the function name and member arguments.
+	iamClient := func(cmd *cobra.Command, args []string, n int) (*gcp.Client, error) {
+		if len(args) != n {
+			if n == 1 {
+				return nil, fmt.Errorf("expected function name")
+			}
+			return nil, fmt.Errorf("expected 2 arguments: function name and member")
+		}
+		proj, _ := cmd.Flags().GetString(projectFlag)
+		if proj == "" {
+			return nil, fmt.Errorf("project not specified")
+		}
+		return newClient(cmd, proj)
+	}
+	printPolicy := func(p *iampb.Policy) {
+		fmt.Printf("etag: %x\n", p.Etag)
+		for _, b := range p.Bindings {
+			if b.Condition != nil {
+				fmt.Printf("%s (if %s)\n", b.Role, b.Condition.Expression)
+			} else {
+				fmt.Println(b.Role)
+			}
+			for _, m := range b.Members {
+				fmt.Println("  " + m)
+			}
+		}
+	}
+
+	iamCmd := &cobra.Command{
+		Use:   "iam",
+		Short: "manage who can call a function",
+	}
+	Root.AddCommand(iamCmd)
 
-	deployStorage := &cobra.Command{
-		Use:   "storage",
-		Short: "deploy storage trigger",
+	iamCmd.AddCommand(&cobra.Command{
+		Use:   "show",
+		Short: "print IAM policy of a function",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) != 2 {
-				return fmt.Errorf("expected 2 arguments: function name and package name")
+			cli, err := iamClient(cmd, args, 1)
+			if err != nil {
+				return err
 			}
-			bucket, _ := cmd.Flags().GetString("bucket")
-			if bucket == "" {
-				return fmt.Errorf("topic not specified")
+			defer cli.Close()
+			p, err := cli.GetIAMPolicy(context.Background(), args[0])
+			if err != nil {
+				return err
 			}
-			event, _ := cmd.Flags().GetString("event")
-			if event != "" && !strings.HasPrefix(event, gcp.StorageEventPref) {
-				event = gcp.StorageEventPref + event
+			printPolicy(p)
+			return nil
+		},
+	})
+	iamCmd.AddCommand(&cobra.Command{
+		Use:   "add-invoker",
+		Short: "allow a member to call a function (e.g. allUsers or user:me@example.com)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := iamClient(cmd, args, 2)
+			if err != nil {
+				return err
 			}
-			name, pkg := args[0], args[1]
-			t, err := gcp.ParseTarget(pkg)
+			defer cli.Close()
+			p, err := cli.AddInvoker(context.Background(), args[0], args[1])
 			if err != nil {
 				return err
 			}
-			return deployTrigger(cmd, name, gcp.StorageTrigger{
-				Target: t, Bucket: bucket,
-				//Event: gcp.StorageEvent(event),
-			})
+			printPolicy(p)
+			return nil
 		},
-	}
-	deployStorage.Flags().StringP("bucket", "b", "", "bucket name")
-	//deployStorage.Flags().StringP("event", "e", "", "event type")
-	deployCmd.AddCommand(deployStorage)
+	})
+	iamCmd.AddCommand(&cobra.Command{
+		Use:   "remove-invoker",
+		Short: "remove a member from invokers of a function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cli, err := iamClient(cmd, args, 2)
+			if err != nil {
+				return err
+			}
+			defer cli.Close()
+			p, err := cli.RemoveInvoker(context.Background(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+			printPolicy(p)
+			return nil
+		},
+	})
 
 	listCmd := &cobra.Command{
 		Use:   "list",
@@ -183,25 +954,162 @@ func init() {
 				return fmt.Errorf("expected project name")
 			}
 			proj := args[0]
-			cli, err := gcp.NewClient(proj)
+			cli, err := newClient(cmd, proj)
 			if err != nil {
 				return err
 			}
+			defer cli.Close()
+			filter, _ := cmd.Flags().GetStringArray(labelFlag)
+			labels, err := parseKeyValues(filter)
+			if err != nil {
+				return fmt.Errorf("invalid label filter: %v", err)
+			}
 			list, err := cli.ListFuncs()
 			if err != nil {
 				return err
 			}
 			for _, f := range list {
-				fmt.Println(f.Name)
+				if gcp.MatchLabels(f, labels) {
+					fmt.Println(f.Name)
+				}
 			}
 			return nil
 		},
 	}
+	listCmd.Flags().StringArray(labelFlag, nil, "only list functions with the label, as key=value")
 	Root.AddCommand(listCmd)
+
+	describeCmd := &cobra.Command{
+		Use:   "describe",
+		Short: "print settings and labels of a deployed function",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("expected function name")
+			}
+			proj, _ := cmd.Flags().GetString(projectFlag)
+			if proj == "" {
+				return fmt.Errorf("project not specified")
+			}
+			cli, err := newClient(cmd, proj)
+			if err != nil {
+				return err
+			}
+			defer cli.Close()
+			f, err := cli.GetFunc(context.Background(), args[0])
+			if err != nil {
+				return err
+			}
+			return gcp.Describe(os.Stdout, f)
+		},
+	}
+	Root.AddCommand(describeCmd)
+
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "write deployed functions to a manifest; all of them unless names are passed",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proj, _ := cmd.Flags().GetString(projectFlag)
+			if proj == "" {
+				return fmt.Errorf("project not specified")
+			}
+			cli, err := newClient(cmd, proj)
+			if err != nil {
+				return err
+			}
+			defer cli.Close()
+			ctx := context.Background()
+			var list []*gcp.CloudFunction
+			if len(args) == 0 {
+				list, err = cli.ListFuncs()
+				if err != nil {
+					return err
+				}
+			}
+			for _, name := range args {
+				f, err := cli.GetFunc(ctx, name)
+				if err != nil {
+					return fmt.Errorf("cannot get function %s: %v", name, err)
+				}
+				list = append(list, f)
+			}
+			exported := make([]*exportedFunc, 0, len(list))
+			for _, f := range list {
+				e := exportFunc(ctx, cli, f)
+				for _, n := range e.notes {
+					log.Printf("%s: not exported: %s", e.Name, n)
+				}
+				exported = append(exported, e)
+			}
+			out, _ := cmd.Flags().GetString(outputFlag)
+			if out == "" {
+				return writeManifest(os.Stdout, exported)
+			}
+			f, err := os.Create(out)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err = writeManifest(f, exported); err != nil {
+				return err
+			}
+			log.Printf("exported %d functions to %s", len(exported), out)
+			return f.Close()
+		},
+	}
+	exportCmd.Flags().StringP(outputFlag, "o", "", "output file (default is stdout)")
+	Root.AddCommand(exportCmd)
+
+	versionCmd := &cobra.Command{
+		Use:   "version",
+		Short: "print cloudfunc version",
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Println(gcp.Version())
+			fmt.Println("shim:", gcp.DefaultShim().Version())
+		},
+	}
+	Root.AddCommand(versionCmd)
+}
+
+// printProgress returns a function that logs the progress of a deploy,
+// with a prefix if it's set.
+func printProgress(prefix string) func(p gcp.Progress) {
+	const waitReportInterval = 15 * time.Second
+	lastPct := -1
+	var lastWait time.Duration
+	return func(p gcp.Progress) {
+		switch p.Phase {
+		case gcp.PhaseUpload:
+			if p.Total == 0 {
+				if p.Bytes == 0 {
+					log.Println(prefix + "uploading archive")
+				}
+				return
+			}
+			if pct := int(p.Bytes*10/p.Total) * 10; pct != lastPct {
+				lastPct = pct
+				log.Printf("%suploading archive: %d%% of %d bytes", prefix, pct, p.Total)
+			}
+		case gcp.PhaseCreate:
+			log.Println(prefix + "creating function")
+		case gcp.PhaseUpdate:
+			log.Println(prefix + "updating function")
+		case gcp.PhaseWait:
+			if lastWait == 0 {
+				log.Println(prefix+"waiting for operation", p.Operation)
+				lastWait = p.Elapsed
+			} else if p.Elapsed-lastWait >= waitReportInterval {
+				log.Printf("%sstill waiting, %v elapsed", prefix, p.Elapsed.Round(time.Second))
+				lastWait = p.Elapsed
+			}
+		case gcp.PhaseDone:
+			log.Printf("%sdeployed in %v", prefix, p.Elapsed.Round(time.Second))
+		}
+	}
 }
 
 func main() {
 	if err := Root.Execute(); err != nil {
 		log.Fatal(err)
 	}
+	os.Exit(exitCode)
 }
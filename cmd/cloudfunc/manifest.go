@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nwca/cloudfunc/gcp"
+	"gopkg.in/yaml.v2"
+)
+
+// manifest lists functions deployed together.
+type manifest struct {
+	Functions []manifestFunc `yaml:"functions"`
+	// dir is the directory of the manifest file.
+	dir string
+}
+
+// manifestFunc is a function in the manifest. Settings that are set override
+// the ones passed by flags.
+type manifestFunc struct {
+	Name string `yaml:"name"`
+	// Trigger is http, pubsub or storage.
+	Trigger string `yaml:"trigger"`
+	// Package with an optional function name, as in the deploy command.
+	// Relative paths are resolved against the manifest directory.
+	Package      string            `yaml:"package"`
+	Topic        string            `yaml:"topic,omitempty"`
+	Bucket       string            `yaml:"bucket,omitempty"`
+	Runtime      string            `yaml:"runtime,omitempty"`
+	Env          map[string]string `yaml:"env_variables,omitempty"`
+	Labels       map[string]string `yaml:"labels,omitempty"`
+	Memory       int               `yaml:"memory,omitempty"`
+	Timeout      time.Duration     `yaml:"timeout,omitempty"`
+	MaxInstances int               `yaml:"max_instances,omitempty"`
+	VPCConnector string            `yaml:"vpc_connector,omitempty"`
+	Ingress      string            `yaml:"ingress,omitempty"`
+}
+
+func readManifest(path string) (*manifest, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{dir: filepath.Dir(path)}
+	if err := yaml.UnmarshalStrict(data, m); err != nil {
+		return nil, fmt.Errorf("cannot parse manifest: %v", err)
+	}
+	seen := make(map[string]bool)
+	var noPkg []string
+	for i, f := range m.Functions {
+		switch {
+		case f.Name == "":
+			return nil, fmt.Errorf("function %d in the manifest has no name", i+1)
+		case seen[f.Name]:
+			return nil, fmt.Errorf("function %s is listed twice in the manifest", f.Name)
+		case f.Package == "":
+			noPkg = append(noPkg, f.Name)
+		}
+		seen[f.Name] = true
+	}
+	// exported manifests have no packages, report all of them at once
+	if len(noPkg) == 1 {
+		return nil, fmt.Errorf("function %s has no package", noPkg[0])
+	} else if len(noPkg) != 0 {
+		return nil, fmt.Errorf("functions have no package: %s", strings.Join(noPkg, ", "))
+	}
+	return m, nil
+}
+
+// selected returns functions with given names, or all functions if no names are set.
+func (m *manifest) selected(names []string) ([]manifestFunc, error) {
+	if len(names) == 0 {
+		return m.Functions, nil
+	}
+	byName := make(map[string]manifestFunc, len(m.Functions))
+	for _, f := range m.Functions {
+		byName[f.Name] = f
+	}
+	out := make([]manifestFunc, 0, len(names))
+	for _, name := range names {
+		f, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("function %s is not in the manifest", name)
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// trigger parses the package of the function and returns its trigger.
+func (f manifestFunc) trigger(dir string) (gcp.Trigger, error) {
+	pkg := f.Package
+	if strings.HasPrefix(pkg, ".") {
+		p, err := filepath.Abs(filepath.Join(dir, pkg))
+		if err != nil {
+			return nil, err
+		}
+		pkg = p
+	}
+	t, err := gcp.ParseTarget(pkg)
+	if err != nil {
+		return nil, err
+	}
+	switch f.Trigger {
+	case "", "http":
+		return gcp.HTTPTrigger{Target: t}, nil
+	case "pubsub":
+		if f.Topic == "" {
+			return nil, fmt.Errorf("topic not specified")
+		}
+		return gcp.TopicTrigger{Target: t, Topic: f.Topic}, nil
+	case "storage":
+		if f.Bucket == "" {
+			return nil, fmt.Errorf("bucket not specified")
+		}
+		return gcp.StorageTrigger{Target: t, Bucket: f.Bucket}, nil
+	}
+	return nil, fmt.Errorf("unknown trigger %q", f.Trigger)
+}
+
+// buildOptions returns a copy of the options with the environment and runtime of the function.
+func (f manifestFunc) buildOptions(base *gcp.BuildOptions) *gcp.BuildOptions {
+	opt := *base
+	opt.Env = make(map[string]string, len(base.Env)+len(f.Env))
+	for k, v := range base.Env {
+		opt.Env[k] = v
+	}
+	for k, v := range f.Env {
+		opt.Env[k] = v
+	}
+	if f.Runtime != "" {
+		opt.Runtime = f.Runtime
+	}
+	return &opt
+}
+
+// setOn overrides deploy settings set in the manifest.
+func (f manifestFunc) setOn(opt *gcp.DeployOptions) {
+	if f.Labels != nil {
+		opt.Labels = f.Labels
+	}
+	if f.Memory != 0 {
+		opt.Memory = f.Memory
+	}
+	if f.Timeout != 0 {
+		opt.Timeout = f.Timeout
+	}
+	if f.MaxInstances != 0 {
+		opt.MaxInstances = f.MaxInstances
+	}
+	if f.VPCConnector != "" {
+		opt.VPCConnector = f.VPCConnector
+	}
+	if f.Ingress != "" {
+		opt.Ingress = f.Ingress
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Statuses of functions in the summary of a multi-function deploy.
+const (
+	statusDeployed  = "deployed"
+	statusUnchanged = "unchanged"
+	statusStarted   = "started"
+	statusFailed    = "failed"
+	statusSkipped   = "skipped"
+)
+
+// deployResult is the result of deploying one of many functions.
+type deployResult struct {
+	Name     string
+	Status   string
+	Duration time.Duration
+	Err      error
+}
+
+// runParallel calls fnc for each name, running at most n calls at a time.
+// Results are returned in the order of names. If failFast is set, the first
+// failure cancels the context of running calls and the rest are skipped.
+func runParallel(ctx context.Context, names []string, n int, failFast bool, fnc func(ctx context.Context, i int) (string, error)) []deployResult {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	res := make([]deployResult, len(names))
+	sem := make(chan struct{}, n)
+	var wg sync.WaitGroup
+	for i, name := range names {
+		res[i] = deployResult{Name: name, Status: statusSkipped}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			continue
+		}
+		if ctx.Err() != nil {
+			<-sem
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			start := time.Now()
+			status, err := fnc(ctx, i)
+			r := &res[i]
+			r.Duration = time.Since(start)
+			if err != nil {
+				r.Status, r.Err = statusFailed, err
+				if failFast {
+					cancel()
+				}
+				return
+			}
+			r.Status = status
+		}(i)
+	}
+	wg.Wait()
+	return res
+}
+
+// printSummary writes a table of results.
+func printSummary(w io.Writer, res []deployResult) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "FUNCTION\tSTATUS\tDURATION\tERROR")
+	for _, r := range res {
+		dur, msg := "-", ""
+		if r.Duration > 0 {
+			dur = r.Duration.Round(100 * time.Millisecond).String()
+		}
+		if r.Err != nil {
+			msg = strings.Replace(r.Err.Error(), "\n", " ", -1)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Name, r.Status, dur, msg)
+	}
+	return tw.Flush()
+}
+
+// countFailed returns the number of failed and skipped functions.
+func countFailed(res []deployResult) int {
+	n := 0
+	for _, r := range res {
+		if r.Status == statusFailed || r.Status == statusSkipped {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunParallel(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e", "f"}
+	cases := []struct {
+		name     string
+		n        int
+		fail     map[int]bool
+		failFast bool
+		exp      []string
+		failed   int
+	}{
+		{
+			name: "sequential",
+			n:    1,
+			exp:  []string{"deployed", "deployed", "deployed", "deployed", "deployed", "deployed"},
+		},
+		{
+			name: "parallel",
+			n:    3,
+			exp:  []string{"deployed", "deployed", "deployed", "deployed", "deployed", "deployed"},
+		},
+		{
+			name:   "failures",
+			n:      2,
+			fail:   map[int]bool{1: true, 4: true},
+			exp:    []string{"deployed", "failed", "deployed", "deployed", "failed", "deployed"},
+			failed: 2,
+		},
+		{
+			name:     "fail fast",
+			n:        1,
+			fail:     map[int]bool{1: true},
+			failFast: true,
+			exp:      []string{"deployed", "failed", "skipped", "skipped", "skipped", "skipped"},
+			failed:   5,
+		},
+		{
+			name:   "more workers than functions",
+			n:      10,
+			fail:   map[int]bool{5: true},
+			exp:    []string{"deployed", "deployed", "deployed", "deployed", "deployed", "failed"},
+			failed: 1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var running, peak int32
+			res := runParallel(context.Background(), names, c.n, c.failFast, func(ctx context.Context, i int) (string, error) {
+				cur := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+				for {
+					p := atomic.LoadInt32(&peak)
+					if cur <= p || atomic.CompareAndSwapInt32(&peak, p, cur) {
+						break
+					}
+				}
+				time.Sleep(5 * time.Millisecond)
+				if c.fail[i] {
+					return "", errors.New("deploy failed")
+				}
+				return statusDeployed, nil
+			})
+			if p := int(atomic.LoadInt32(&peak)); p > c.n {
+				t.Errorf("expected at most %d concurrent calls, got %d", c.n, p)
+			}
+			if len(res) != len(names) {
+				t.Fatalf("unexpected results: %v", res)
+			}
+			for i, r := range res {
+				if r.Name != names[i] || r.Status != c.exp[i] {
+					t.Errorf("%d: expected %s %s, got %s %s", i, names[i], c.exp[i], r.Name, r.Status)
+				}
+				if (r.Err != nil) != (r.Status == statusFailed) {
+					t.Errorf("%s: unexpected error: %v", r.Name, r.Err)
+				}
+			}
+			if n := countFailed(res); n != c.failed {
+				t.Errorf("expected %d failed, got %d", c.failed, n)
+			}
+		})
+	}
+}
+
+func TestPrintSummary(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	err := printSummary(buf, []deployResult{
+		{Name: "hello", Status: statusDeployed, Duration: 1234 * time.Millisecond},
+		{Name: "events", Status: statusFailed, Duration: time.Second, Err: errors.New("build\nfailed")},
+		{Name: "other", Status: statusSkipped},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exp := []string{
+		"FUNCTION  STATUS    DURATION  ERROR",
+		"hello     deployed  1.2s",
+		"events    failed    1s        build failed",
+		"other     skipped   -",
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != len(exp) {
+		t.Fatalf("unexpected summary:\n%s", buf.String())
+	}
+	for i, l := range lines {
+		if strings.TrimRight(l, " ") != exp[i] {
+			t.Errorf("line %d: expected %q, got %q", i+1, exp[i], l)
+		}
+	}
+}
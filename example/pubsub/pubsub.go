@@ -6,23 +6,22 @@ import (
 	"time"
 
 	"cloud.google.com/go/pubsub"
+	"github.com/nwca/cloudfunc"
 )
 
-var (
-	cli  *pubsub.Client
-	gerr error
-)
+var cli *pubsub.Client
 
 func init() {
-	cli, gerr = pubsub.NewClient(context.Background(), os.Getenv("GCLOUD_PROJECT"))
+	cloudfunc.OnInit(func(ctx context.Context) error {
+		var err error
+		cli, err = pubsub.NewClient(ctx, os.Getenv("GCLOUD_PROJECT"))
+		return err
+	})
 }
 
 var start = time.Now()
 
 func HandleTopic(ctx context.Context, m *pubsub.Message) error {
-	if gerr != nil {
-		return gerr
-	}
 	m.Attributes["uptime"] = time.Since(start).String()
 	_, err := cli.Topic("test").Publish(ctx, m).Get(ctx)
 	return err
@@ -0,0 +1,20 @@
+package cloudfunc
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Path returns the path of a file bundled into the function archive.
+// The name is resolved relative to the code location of the function,
+// or to the current directory when the function runs locally.
+func Path(name string) string {
+	if filepath.IsAbs(name) {
+		return name
+	}
+	dir := os.Getenv("CODE_LOCATION")
+	if dir == "" {
+		return name
+	}
+	return filepath.Join(dir, filepath.FromSlash(name))
+}
@@ -0,0 +1,164 @@
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	"google.golang.org/api/transport"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// tokenInfoURL returns information about an access token.
+const tokenInfoURL = "https://oauth2.googleapis.com/tokeninfo"
+
+// authConfig selects credentials used by all clients.
+// Application default credentials are used if nothing is set.
+type authConfig struct {
+	credsFile   string
+	token       string
+	impersonate string
+}
+
+// WithCredentialsFile sets a credentials file used by all clients.
+func WithCredentialsFile(path string) ClientOption {
+	return func(c *clientConfig) {
+		c.auth.credsFile = path
+	}
+}
+
+// WithAccessToken sets an OAuth2 access token used by all clients.
+func WithAccessToken(token string) ClientOption {
+	return func(c *clientConfig) {
+		c.auth.token = token
+	}
+}
+
+// WithImpersonation makes all clients act as a given service account.
+// The base credentials must be allowed to create tokens for it.
+func WithImpersonation(serviceAccount string) ClientOption {
+	return func(c *clientConfig) {
+		c.auth.impersonate = serviceAccount
+	}
+}
+
+// baseOptions returns client options for credentials other than impersonation.
+func (a authConfig) baseOptions() ([]option.ClientOption, error) {
+	switch {
+	case a.token != "" && a.credsFile != "":
+		return nil, fmt.Errorf("access token and credentials file cannot be used together")
+	case a.token != "":
+		return []option.ClientOption{
+			option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: a.token})),
+		}, nil
+	case a.credsFile != "":
+		return []option.ClientOption{option.WithCredentialsFile(a.credsFile)}, nil
+	}
+	return nil, nil
+}
+
+// options returns client options that apply the credentials.
+func (a authConfig) options(ctx context.Context) ([]option.ClientOption, error) {
+	opts, err := a.baseOptions()
+	if err != nil || a.impersonate == "" {
+		return opts, err
+	}
+	ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: a.impersonate,
+		Scopes:          []string{cloudPlatformScope},
+	}, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot impersonate %s: %v", a.impersonate, err)
+	}
+	return []option.ClientOption{option.WithTokenSource(ts)}, nil
+}
+
+// errNoEmail is returned by tokenAccount for tokens without the email scope.
+var errNoEmail = errors.New("token has no email scope")
+
+// Identity returns the account used by the client and a description of
+// where its credentials come from. The account is empty if the credentials
+// don't reveal it, e.g. access tokens and user credentials without the email
+// scope.
+func (c *Client) Identity(ctx context.Context) (account, source string, err error) {
+	a := c.auth
+	switch {
+	case a.impersonate != "":
+		return a.impersonate, "impersonated service account", nil
+	case a.token != "":
+		account, err = tokenAccount(ctx, c.http, a.token)
+		if err == errNoEmail {
+			err = nil
+		}
+		return account, "access token", err
+	}
+	var creds *google.Credentials
+	if a.credsFile != "" {
+		source = "credentials file " + a.credsFile
+		data, err := ioutil.ReadFile(a.credsFile)
+		if err != nil {
+			return "", source, err
+		}
+		creds, err = google.CredentialsFromJSON(ctx, data, cloudPlatformScope)
+		if err != nil {
+			return "", source, err
+		}
+	} else {
+		source = "application default credentials"
+		creds, err = transport.Creds(ctx, option.WithScopes(cloudPlatformScope))
+		if err != nil {
+			return "", source, err
+		}
+	}
+	// service account keys have the email, user credentials don't
+	var key struct {
+		Email string `json:"client_email"`
+	}
+	if json.Unmarshal(creds.JSON, &key) == nil && key.Email != "" {
+		return key.Email, source, nil
+	}
+	tok, err := creds.TokenSource.Token()
+	if err != nil {
+		return "", source, err
+	}
+	account, err = tokenAccount(ctx, c.http, tok.AccessToken)
+	if err == errNoEmail {
+		err = nil
+	}
+	return account, source, err
+}
+
+// tokenAccount returns the email of the account an access token belongs to.
+func tokenAccount(ctx context.Context, cli *http.Client, token string) (string, error) {
+	req, err := http.NewRequest("GET", tokenInfoURL+"?access_token="+url.QueryEscape(token), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := cli.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot get token info: %s", resp.Status)
+	}
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return "", fmt.Errorf("cannot get token info: %v", err)
+	}
+	if info.Email == "" {
+		return "", errNoEmail
+	}
+	return info.Email, nil
+}
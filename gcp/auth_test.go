@@ -0,0 +1,62 @@
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBaseOptions(t *testing.T) {
+	cases := []struct {
+		name string
+		auth authConfig
+		opts int
+		err  bool
+	}{
+		{name: "default", opts: 0},
+		{name: "token", auth: authConfig{token: "tok"}, opts: 1},
+		{name: "file", auth: authConfig{credsFile: "key.json"}, opts: 1},
+		{name: "token and file", auth: authConfig{token: "tok", credsFile: "key.json"}, err: true},
+		{name: "impersonate only", auth: authConfig{impersonate: "sa@p.iam.gserviceaccount.com"}, opts: 0},
+		{name: "token and impersonate", auth: authConfig{token: "tok", impersonate: "sa@p.iam.gserviceaccount.com"}, opts: 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opts, err := c.auth.baseOptions()
+			if c.err {
+				if err == nil {
+					t.Fatalf("expected an error, got %d options", len(opts))
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if len(opts) != c.opts {
+				t.Fatalf("expected %d options, got %d", c.opts, len(opts))
+			}
+		})
+	}
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+func TestIdentityWithoutEmail(t *testing.T) {
+	c := &Client{
+		auth: authConfig{token: "tok"},
+		http: &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			rec := httptest.NewRecorder()
+			rec.WriteString(`{"scope": "https://www.googleapis.com/auth/cloud-platform"}`)
+			return rec.Result(), nil
+		})},
+	}
+	account, source, err := c.Identity(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if account != "" || source != "access token" {
+		t.Fatalf("unexpected identity: %q (%s)", account, source)
+	}
+}
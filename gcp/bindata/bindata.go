@@ -1,444 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// ../nodego/env.go
-// ../nodego/http.go
-// ../nodego/main.go
-// ../nodego/nodego.go
-// ../nodego/nodego_local.go
-// ../nodego/pubsub.go
-// ../nodego/storage.go
-// ../nodego/supervisor.go
-// ../nodego/types.go
-// ../function.tar
-// DO NOT EDIT!
-
-package bindata
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _nodegoEnvGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x54\x51\x6f\xe2\x48\x13\x7c\x66\x7e\x45\xcb\x4f\xf0\x7d\xc4\x26\xd1\x6e\x4e\xba\x53\x4e\x62\x89\x93\xf8\x96\xb5\x11\x76\xb2\x97\x27\x34\x8c\xdb\x76\xef\xda\x33\xbe\x99\x71\x00\x9d\xf6\xbf\x9f\x06\x4c\x12\x92\xdd\x79\xc1\xd0\x55\xd5\x35\xd5\x6d\x82\x00\x66\xaa\xdd\x69\x2a\x2b\x0b\x17\x93\xf3\xdf\xe0\x56\xa9\xb2\x46\x88\xa4\xf0\x59\x10\xb0\x20\x80\x39\x09\x94\x06\x73\xe8\x64\x8e\x1a\x6c\x85\x30\x6d\xb9\xa8\xf0\x58\x19\xc3\x03\x6a\x43\x4a\xc2\x85\x3f\x81\xa1\x03\x78\x7d\xc9\x1b\xfd\xe1\x24\x76\xaa\x83\x86\xef\x40\x2a\x0b\x9d\x41\xb0\x15\x19\x28\xa8\x46\xc0\xad\xc0\xd6\x02\x49\x10\xaa\x69\x6b\xe2\x52\x20\x6c\xc8\x56\xfb\x3e\xbd\x8a\x73\x02\x8f\xbd\x86\x5a\x5b\x4e\x12\x38\x08\xd5\xee\x40\x15\xaf\x81\xc0\x6d\x6f\xda\x9d\xca\xda\xf6\xf7\x20\xd8\x6c\x36\x3e\xdf\x1b\xf6\x95\x2e\x83\xfa\x00\x35\xc1\x3c\x9a\x85\x71\x1a\x9e\x5d\xf8\x93\x9e\x74\x2f\x6b\x34\x06\x34\xfe\xd3\x91\xc6\x1c\xd6\x3b\xe0\x6d\x5b\x93\xe0\xeb\x1a\xa1\xe6\x1b\x50\x1a\x78\xa9\x11\x73\xb0\xca\x99\xde\x68\xb2\x24\xcb\x31\x18\x55\xd8\x0d\xd7\xe8\x64\x72\x32\x56\xd3\xba\xb3\x27\x99\x1d\x2d\x92\x39\x01\x28\x09\x5c\x82\x37\x4d\x21\x4a\x3d\xf8\x34\x4d\xa3\x74\xec\x44\xbe\x46\xd9\x5d\x72\x9f\xc1\xd7\xe9\x72\x39\x8d\xb3\x28\x4c\x21\x59\xc2\x2c\x89\xaf\xa3\x2c\x4a\xe2\x14\x92\x1b\x98\xc6\x8f\xf0\x39\x8a\xaf\xc7\x80\x64\x2b\xd4\x80\xdb\x56\xbb\x1b\x28\x0d\xe4\xd2\xc4\x7c\x1f\x5d\x8a\x78\x62\xa1\x50\x07\x4b\xa6\x45\x41\x05\x09\xa8\xb9\x2c\x3b\x5e\x22\x94\xea\x09\xb5\x24\x59\x42\x8b\xba\x21\xe3\xa6\x6a\x80\xcb\xdc\xc9\xd4\xd4\x90\xe5\x76\xff\xd3\xbb\x7b\xf9\x8c\xb5\x5c\x7c\x77\x22\x0d\x27\xc9\x18\x35\xad\xd2\x16\x86\x6c\xe0\x29\xe3\xb1\x81\x67\xac\x16\x4a\x3e\xb9\x47\x4b\x0d\x7a\x6c\xc4\x9c\xea\x03\xd7\xe4\xf2\x35\x6e\xa0\x84\x39\x14\x5a\x35\xb0\x51\xfa\x3b\x6a\xff\x9b\xf1\xd9\x13\xd7\x4e\x45\xa8\x1c\xe7\x4a\xec\xfb\x5f\x93\x86\xfe\x5c\x81\x32\xfe\x2d\x5a\x94\x4f\x43\x6f\x96\x5c\x87\xab\x79\x32\x9b\xba\x88\xbc\x11\x1b\xf4\x96\xfe\x32\x4a\xde\xb8\x75\x7b\x26\xbd\x55\xfb\x3f\x78\x41\x0f\xf6\xbf\x19\x25\x3d\x36\x40\x69\xf5\x6e\xa1\x48\x5a\x78\x7d\x4e\x1a\x86\x71\xb6\x7c\x5c\x2d\x92\x28\xce\x5c\x3b\xd3\xb5\xa8\x9f\xc8\x28\x7d\xa7\x8c\x95\xbc\xc1\xf7\x94\xf4\x7e\x11\x2e\x1f\xa2\x34\x59\xae\xee\x92\x34\x8b\xa7\x5f\xc2\x53\x6a\x24\x2d\x6a\xc9\xeb\x85\x8b\xef\x57\xd4\x28\xce\xc2\x65\x3c\x9d\xaf\x16\xc9\x72\xdf\xba\xe8\xa4\x70\x97\xc9\x34\x95\x25\xea\x6c\xd7\xe2\xbb\xd6\x37\xf7\xf1\xcc\x25\xb3\xca\x96\xd1\xed\x6d\xb8\x5c\x65\x8f\x8b\xf0\x35\x39\x3e\x3a\xfe\xc9\x55\x9f\xc9\x47\xc7\xcf\x1d\xa9\x41\xd5\xd9\x14\xc5\x18\x56\x8e\xd4\x0f\xda\x5f\x70\x6d\x30\x92\x76\xf8\x53\x0b\xd1\x97\x30\xb9\xcf\x56\x69\x38\xf3\x46\x63\x38\x9f\x8c\xe1\xf2\xc3\xa8\xdf\x89\x99\x92\xc6\x72\x69\x7f\xb9\x13\xc2\x01\xdc\x56\x1c\x4d\xa4\x96\xdb\xce\xdc\x21\xcf\x51\xdf\x10\xd6\x39\x5c\x81\xf7\xf7\xd9\xe1\xdf\xec\xec\x50\xf5\xd8\xa0\x40\x2b\x2a\xd4\x89\xa6\x92\xe4\x9b\xb1\xbe\xc0\x6f\x0e\xa8\xb3\x03\xcc\xad\xc2\x16\x45\x67\x71\xa1\xb1\xa0\xed\x5b\x5a\xd0\x57\x3d\xc6\x06\x0d\xdf\xce\x55\x39\x47\x59\xda\xea\x35\xe8\xe3\x64\x32\x39\x56\x3f\x71\x2b\xaa\x50\x5a\x4d\x68\x0e\xd5\xf3\x8f\x6f\xaa\xaf\x04\x0e\x55\x57\x7f\xd9\x90\xcf\x54\xd7\x7d\xea\x4e\x1b\xfe\x07\xee\x85\xf2\x53\x14\x4a\xe6\x2e\xc2\xfe\x95\x79\x61\xcc\x55\xf9\x42\xd8\x83\xaf\x3b\xbd\x5f\xfe\x61\xc3\xb7\xc3\xcb\xc9\x18\xde\x8f\x73\x34\x7a\xaf\xec\x50\xe0\x28\x7c\x0c\x6b\x20\x69\x2f\x3f\x8c\x0e\x1f\xf0\x2f\x1b\x50\x01\x1c\xfe\x84\xb5\x7b\x1e\x68\xb4\x9d\x96\xc0\xd9\xe0\x07\x3b\x7e\x59\xb3\x1f\xac\x1f\xde\x5d\x96\x2d\xfa\x65\x85\x2b\x38\x49\x98\xfd\x17\x00\x00\xff\xff\x45\xc8\x69\x1a\x97\x06\x00\x00")
-
-func nodegoEnvGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoEnvGo,
-		"nodego/env.go",
-	)
-}
-
-func nodegoEnvGo() (*asset, error) {
-	bytes, err := nodegoEnvGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/env.go", size: 1687, mode: os.FileMode(436), modTime: time.Unix(1526756142, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoHttpGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xdf\x4f\xdb\x30\x10\x7e\xb6\xff\x8a\x9b\x9f\x92\x11\x85\x77\xa6\x3c\x15\x58\x91\x18\xab\x0a\x88\x49\xd3\x84\x32\xe7\x52\x5b\x4b\xed\xec\x7c\x69\x41\x55\xfe\xf7\xc9\x49\x0a\xb4\x4c\xdb\x4b\xdb\x7c\xbe\xef\x87\xbf\x5e\xda\x52\xff\x2a\x57\x08\xeb\xd2\x3a\x29\xed\xba\xf5\xc4\x90\x48\xa1\x1a\xbf\x52\x52\x28\x87\x7c\x6a\x98\xdb\xf8\xdb\x87\xf8\x19\x98\xac\x5b\x05\x25\x53\x29\x37\x25\x41\x6d\x9f\x96\x58\x59\x42\xcd\x01\x0a\xf0\x21\xff\x8c\x8c\x6e\x93\xa8\xd9\xf5\xd7\xfb\xf3\xcb\xfb\x9b\xd9\xe3\xe5\xd5\xb7\xc7\xe5\xc5\xf9\xd5\xf2\x62\x76\x77\xab\x52\x28\x0a\x50\x4c\x1d\x2a\x29\xeb\xce\x69\x98\x97\xae\x6a\x70\x7e\x77\xb7\x48\x6a\xa7\x21\x1a\xe6\x23\x46\x97\x9d\xd3\x29\xec\xa4\x88\x5e\xe6\xe0\x08\x0a\xa8\x9d\x96\xc2\x40\x31\xe2\xb7\x4c\xb6\x5d\x10\xd6\xf6\x29\xc1\x27\xd4\x1d\xe3\xf8\x94\x81\x49\xa5\xb0\xf5\x61\xd8\x9d\x14\x03\x97\x26\x64\x92\xdd\x99\x5e\x8a\x5e\x8a\x37\x56\x89\x3a\x55\x19\x3c\x58\x36\xd7\x7e\xb5\x42\x4a\x4c\x9a\xca\x5e\x4a\x7e\x6e\xf1\x98\x0e\x81\xa9\xd3\x1c\xd5\x0f\xe3\x46\xc2\x70\xdb\xc4\x1c\x73\x52\xb8\x45\xda\x8c\x05\x6c\x47\xd2\x12\x43\xeb\x5d\xc0\x07\xb2\x8c\x94\x01\xc1\xc7\x09\xff\xdd\x61\xe0\xa1\x12\x93\x9b\xfc\x95\xb8\xd7\x1c\x19\xbb\x6d\x9f\x01\xbd\x4f\x39\x9e\xbe\x09\xf9\x57\xbf\xd7\xac\xdb\x23\x66\x0a\x73\x2c\x2b\xa4\x24\x9d\x2e\x37\x3c\x45\x21\x42\xee\xc8\xc1\x36\xdf\xe6\xfb\x91\x7f\xc9\x0c\xdf\x49\x0b\xdf\x7f\xfc\x7c\x66\x4c\x21\xb1\x8e\x33\x40\x22\x4f\xe9\x91\xdc\x34\xfa\x7f\xb9\xc9\x37\x70\xc9\x5d\x98\xf9\x0a\xc1\xba\xb1\x2a\x5b\xc3\x1b\xb4\x78\x59\x98\x08\x7d\xf1\x1b\xac\x16\x48\xeb\xd2\xa1\xe3\xe6\x79\x5a\x8c\xb3\xe2\xf0\x2e\x42\x68\xef\x02\x83\xa9\xe2\xe6\xa9\x6b\xaf\x4b\xb6\xde\x29\x29\x44\xe3\x75\x1c\x37\x71\xf5\x13\x53\x51\x1c\xb6\x35\xb4\x84\x75\xc4\xd5\xa9\x82\x13\x88\xc9\x23\xe1\xa6\x5c\xe3\x27\xf8\x30\xbd\x47\xf9\xbc\x0c\xd3\xca\x36\x5e\x67\x03\x67\x48\x3c\xaa\x16\xa3\xc8\x09\x34\x5e\x47\x2c\xfe\xe1\x83\x45\x16\x91\xe8\xd3\x0f\xfe\xab\x7c\x41\xd6\x71\xe3\x12\xb5\x6f\xe6\x4c\xed\x67\x7a\x29\x5e\x6a\x7c\x57\x51\x6c\xf5\x4f\x00\x00\x00\xff\xff\x92\x07\x42\xa8\x06\x04\x00\x00")
-
-func nodegoHttpGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoHttpGo,
-		"nodego/http.go",
-	)
-}
-
-func nodegoHttpGo() (*asset, error) {
-	bytes, err := nodegoHttpGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/http.go", size: 1030, mode: os.FileMode(436), modTime: time.Unix(1527207699, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoMainGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x91\xc1\x6e\xd4\x30\x10\x86\xcf\xf5\x53\xfc\xca\x69\x57\x5a\x92\xd2\x0b\x12\x9c\x42\x5b\x20\xa2\x4a\x50\xb3\xa5\xea\xd1\xeb\x4c\x9c\x51\x13\xdb\xd8\x4e\xd3\x15\xe2\xdd\x91\xb7\xa9\x44\x85\x8f\x9e\x7f\x3e\x7f\x33\x2e\x0a\x5c\x5a\x77\xf4\xac\x87\x88\x8b\xf3\xf7\x1f\xf0\xd5\x5a\x3d\x12\x2a\xa3\x72\x51\x14\xa2\x28\x70\xc3\x8a\x4c\xa0\x0e\xb3\xe9\xc8\x23\x0e\x84\xd2\x49\x35\xd0\x6b\x65\x87\x9f\xe4\x03\x5b\x83\x8b\xfc\x1c\x9b\x14\xc8\xd6\x52\xb6\xfd\x94\x10\x47\x3b\x63\x92\x47\x18\x1b\x31\x07\x42\x1c\x38\xa0\xe7\x91\x40\xcf\x8a\x5c\x04\x1b\x28\x3b\xb9\x91\xa5\x51\x84\x85\xe3\x70\x7a\x67\xa5\x24\x13\x3c\xac\x0c\x7b\x88\x92\x0d\x24\x94\x75\x47\xd8\xfe\xdf\x20\x64\x5c\xa5\xd3\x19\x62\x74\x1f\x8b\x62\x59\x96\x5c\x9e\x84\x73\xeb\x75\x31\xbe\x44\x43\x71\x53\x5d\x5e\xd7\xed\xf5\xbb\x8b\xfc\x7c\x6d\xba\x33\x23\x85\x00\x4f\xbf\x66\xf6\xd4\xe1\x70\x84\x74\x6e\x64\x25\x0f\x23\x61\x94\x0b\xac\x87\xd4\x9e\xa8\x43\xb4\x49\x7a\xf1\x1c\xd9\xe8\x1d\x82\xed\xe3\x22\x3d\x25\x4c\xc7\x21\x7a\x3e\xcc\xf1\xcd\xce\x5e\x15\x39\xbc\x09\x58\x03\x69\x90\x95\x2d\xaa\x36\xc3\xe7\xb2\xad\xda\x5d\x82\xdc\x57\xfb\x6f\xcd\xdd\x1e\xf7\xe5\xed\x6d\x59\xef\xab\xeb\x16\xcd\x2d\x2e\x9b\xfa\xaa\xda\x57\x4d\xdd\xa2\xf9\x82\xb2\x7e\xc0\xf7\xaa\xbe\xda\x81\x38\x0e\xe4\x41\xcf\xce\xa7\x09\xac\x07\xa7\x6d\x52\x77\x5a\x5d\x4b\xf4\x46\xa1\xb7\x2f\x4a\xc1\x91\xe2\x9e\x15\x46\x69\xf4\x2c\x35\x41\xdb\x27\xf2\x86\x8d\x86\x23\x3f\x71\x48\xbf\x1a\x20\x4d\x97\x30\x23\x4f\x1c\x65\x3c\x5d\xfd\x37\x57\x2e\x84\x93\xea\x31\x41\x26\xc9\x46\x08\x9e\x9c\xf5\x11\x1b\x71\x96\xf5\xa3\xd4\x99\xd8\x0a\xd1\xcf\x46\x81\x0d\xc7\xcd\x16\xbf\xc5\x59\xf3\x44\xde\x73\x47\x37\x56\x6b\xf2\x9b\xad\xf8\xb3\x46\x12\xe1\x25\x92\x5a\xf3\x1f\xd2\x07\xda\x6c\xc5\xd9\x5e\x3e\x52\x6a\x3a\x45\xff\x06\x00\x00\xff\xff\xbd\xdd\x65\x47\xbc\x02\x00\x00")
-
-func nodegoMainGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoMainGo,
-		"nodego/main.go",
-	)
-}
-
-func nodegoMainGo() (*asset, error) {
-	bytes, err := nodegoMainGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/main.go", size: 700, mode: os.FileMode(436), modTime: time.Unix(1527201338, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoNodegoGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x54\xdd\x6e\xdb\x46\x13\xbd\x26\x9f\x62\x3e\x02\x41\xc8\x7c\x2c\xe9\xf8\xa6\x80\x0b\x5f\xa8\xfe\x49\x84\x04\x52\x60\x29\x35\x82\xb6\x28\x56\xdc\x21\xb5\xd0\x6a\x97\x99\x1d\x8a\x11\x8a\xbc\x7b\x31\x2b\x3a\xb5\xeb\x16\xbd\xa9\x01\x8b\xdc\xdd\x73\xce\x9c\x9d\x1f\xd6\x35\x5c\xf9\xfe\x48\xa6\xdb\x32\x9c\x9f\xbd\xfe\x1e\xde\x78\xdf\x59\x84\xb9\x6b\xaa\xb4\xae\xd3\xba\x86\xf7\xa6\x41\x17\x50\xc3\xe0\x34\x12\xf0\x16\x61\xd6\xab\x66\x8b\x0f\x27\x25\xfc\x84\x14\x8c\x77\x70\x5e\x9d\x41\x2e\x80\x6c\x3a\xca\x8a\x1f\x44\xe2\xe8\x07\xd8\xab\x23\x38\xcf\x30\x04\x04\xde\x9a\x00\xad\xb1\x08\xf8\xa5\xc1\x9e\xc1\x38\x68\xfc\xbe\xb7\x46\xb9\x06\x61\x34\xbc\x8d\x71\x26\x15\x71\x02\x9f\x26\x0d\xbf\x61\x65\x1c\x28\x68\x7c\x7f\x04\xdf\x3e\x06\x82\xe2\xc9\xb4\xfc\x6d\x99\xfb\x8b\xba\x1e\xc7\xb1\x52\xd1\x70\xe5\xa9\xab\xed\x09\x1a\xea\xf7\xf3\xab\x9b\xc5\xea\xe6\xbb\xf3\xea\x6c\x22\x7d\x74\x16\x43\x00\xc2\xcf\x83\x21\xd4\xb0\x39\x82\xea\x7b\x6b\x1a\xb5\xb1\x08\x56\x8d\xe0\x09\x54\x47\x88\x1a\xd8\x8b\xe9\x91\x0c\x1b\xd7\x95\x10\x7c\xcb\xa3\x22\x14\x19\x6d\x02\x93\xd9\x0c\xfc\x24\x67\x0f\x16\x4d\x78\x02\xf0\x0e\x94\x83\x6c\xb6\x82\xf9\x2a\x83\x1f\x67\xab\xf9\xaa\x14\x91\xfb\xf9\xfa\xed\xf2\xe3\x1a\xee\x67\x77\x77\xb3\xc5\x7a\x7e\xb3\x82\xe5\x1d\x5c\x2d\x17\xd7\xf3\xf5\x7c\xb9\x58\xc1\xf2\x16\x66\x8b\x4f\xf0\x6e\xbe\xb8\x2e\x01\x0d\x6f\x91\x00\xbf\xf4\x24\x37\xf0\x04\x46\xb2\x89\x3a\xa6\x6e\x85\xf8\xc4\x42\xeb\x4f\x96\x42\x8f\x8d\x69\x4d\x03\x56\xb9\x6e\x50\x1d\x42\xe7\x0f\x48\xce\xb8\x0e\x7a\xa4\xbd\x09\x52\xd5\x00\xca\x69\x91\xb1\x66\x6f\x58\x71\xdc\x7a\x76\xaf\x2a\x15\xc8\xff\x37\x83\xb1\x1a\x9c\xd7\x18\xd7\x1f\x54\xb3\x13\x5d\xd9\xe8\x3c\xf4\xe4\x0f\x46\x63\x80\x81\x8d\x35\x6c\x30\x44\x2f\x3d\x21\xa3\xd3\x12\x96\x3d\x6c\x4e\xf0\x2a\xed\x27\xf2\x5e\x19\x97\xa6\x66\xdf\x7b\x62\xc8\xd3\x24\x6b\xad\xea\x32\x79\xee\x59\x1e\xd6\xc7\x95\x43\x9e\x1e\xb5\x54\x5e\xde\x7d\x90\xdf\xc0\xd4\x78\x77\x98\x5e\x8d\xeb\x4e\xbb\x47\xd7\x64\x69\x91\xa6\x07\x45\xd0\xea\x00\x97\x20\xba\xd5\x2a\x42\xf2\xac\xd5\x21\x2b\x21\x93\xff\x56\xbf\x2e\x5b\x7d\x5e\x56\x55\x95\x15\xf1\x5e\x6b\xb5\xc3\xe5\x01\x09\x14\x33\xee\x7b\x0e\x62\x9c\xd5\x0e\xc1\xc7\x5d\x6b\xa5\x33\xe5\x1a\x2f\x03\x04\xdf\xec\x50\x20\x5b\xc5\x30\x22\x21\xf8\x1e\x1d\x8c\x5b\x74\x60\xa4\x61\x01\xbf\x60\x73\xc0\x97\xfa\x34\x18\x1b\xe3\x14\x1d\x2b\x58\xff\xb9\x80\xfd\x10\x18\xb6\xea\x80\xb0\x41\x74\x10\x58\x11\x9f\x3a\x54\x6a\x20\x7c\xa4\x53\xda\xeb\x1a\xf6\x5e\x0f\xf6\xa1\xcc\x26\x7a\x1b\x3d\xed\xaa\xb4\x1d\x5c\xf3\xcd\x7b\x5e\xc0\xef\x69\x62\x5a\xb0\xe8\xf2\x57\xad\x0e\x05\x5c\x5e\xc2\x99\x6c\x26\xed\x9e\xab\xdb\x9e\x8c\x63\xeb\x72\x1f\xaa\x15\x6b\x24\x2a\x21\xbb\x7b\x98\x0d\xc9\x55\x4c\xdb\xa8\x42\x9c\xeb\x80\x2c\xd9\x79\xcc\x6d\x9f\x50\x3f\x06\x29\xa6\x6f\xe1\x45\xb8\xf8\xc5\x65\x25\xf8\x50\xcd\xa8\x0b\x3f\x9f\xfd\x1a\x69\x92\xfc\x0f\x42\xbb\xc6\x56\x0d\x96\x43\x5e\xa4\xc9\xd7\x34\x4d\x08\x95\x3e\xc2\xc5\x25\x88\xfd\x7c\x8c\x73\x5d\xdd\x61\xe8\xbd\x0b\x78\x4f\x86\x91\x4a\x20\x78\x35\xed\x7f\x1e\x30\x70\xf1\xfc\x1a\x63\xf4\x80\x14\x65\xa4\x8b\x65\x14\xa3\x76\x16\x03\x25\x91\xff\x56\x39\x6d\xf1\x56\x22\x65\xb5\xf5\x4a\x67\xe5\x09\x54\xfc\x1d\xa0\xd9\x62\xb3\xcb\xca\xff\xc0\xd9\xf2\x5d\x74\x51\xa4\x69\x22\x0d\x39\x76\x20\x0d\x5a\xdd\x2b\xc3\x6f\xc8\x0f\x7d\x9a\x48\x39\x7f\x2b\x41\x51\x27\xb9\x20\xe5\x3a\x84\xa9\x9f\xab\x55\x6f\x0d\xc7\x22\x96\x90\x95\xd9\x14\x43\x97\x80\x44\x82\x9e\x46\xa0\x9a\xb1\x37\xb9\xa2\x4e\x12\x6e\xda\x78\xfa\xbf\x4b\x70\xc6\x46\x42\x62\xfd\x54\x82\x36\xcf\x6e\x88\x3c\x81\xb0\x90\xe4\x1b\x17\x03\xbf\xf8\x7c\xfa\xf0\xf1\x05\xbc\x38\x64\xd1\x4c\x8c\x21\x7a\x49\xe3\x1d\x1b\x37\x60\x9a\x48\x36\x93\x56\x02\xfb\x50\x2d\x70\xbc\x35\x16\xf3\xc1\x38\xee\x99\xf2\x56\x17\x32\x57\x42\xb1\xdf\x0c\x3a\xe4\x4a\x50\xef\x4d\x60\x74\x48\x79\x1b\x7b\xa2\xba\xb2\x3e\x60\xfe\x6f\x76\xad\xfb\xe6\x97\x50\x45\xb7\x8f\xc5\x2e\xb2\x7f\x30\x99\xfe\x45\xe3\x0e\xc3\xb0\x17\xf6\xdb\xf5\xfa\x03\x04\x24\x19\x66\x2f\xbd\x6a\xab\x99\xd6\x94\x17\xa2\x31\x76\xb2\xc8\x5f\xcb\x7b\xe7\x4f\xb5\x2f\x9e\x3b\x8a\x45\x5f\x89\x46\x6e\x4b\x31\x1d\xc9\x89\x7d\x74\x27\x91\xba\xf6\xee\xb4\xf8\xfa\xd0\xef\x63\x17\xcb\x9e\x17\xe9\xd7\xf4\x8f\x00\x00\x00\xff\xff\xad\x6d\xae\xb1\xa1\x07\x00\x00")
-
-func nodegoNodegoGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoNodegoGo,
-		"nodego/nodego.go",
-	)
-}
-
-func nodegoNodegoGo() (*asset, error) {
-	bytes, err := nodegoNodegoGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/nodego.go", size: 1953, mode: os.FileMode(436), modTime: time.Unix(1526756142, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoNodego_localGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x52\x4d\x6f\xe3\x36\x10\x3d\x9b\xbf\xe2\x2d\x4f\x52\xeb\x4a\x6e\x2e\x5d\x64\x91\x83\x9b\xa4\xad\xd0\xd4\x2e\x22\x6f\x17\x7b\xa4\xa5\x91\x34\x58\x8a\x64\x49\x2a\x8e\x51\xec\x7f\x2f\x28\x2b\x41\x83\x02\xab\x0b\x85\xe1\x9b\xf7\x31\xc3\xb2\xc4\xad\x75\x67\xcf\xfd\x10\x71\xb5\xf9\xf1\x27\xfc\x6a\x6d\xaf\x09\x95\x69\x0a\x51\x96\xa2\x2c\xf1\xc0\x0d\x99\x40\x2d\x26\xd3\x92\x47\x1c\x08\x5b\xa7\x9a\x81\x5e\x6e\xd6\xf8\x8b\x7c\x60\x6b\x70\x55\x6c\x90\x25\x80\x5c\xae\x64\xfe\x21\x51\x9c\xed\x84\x51\x9d\x61\x6c\xc4\x14\x08\x71\xe0\x80\x8e\x35\x81\x9e\x1b\x72\x11\x6c\xd0\xd8\xd1\x69\x56\xa6\x21\x9c\x38\x0e\xb3\xce\xc2\x92\x9c\xe0\xf3\xc2\x61\x8f\x51\xb1\x81\x42\x63\xdd\x19\xb6\xfb\x2f\x10\x2a\x2e\xa6\xd3\x37\xc4\xe8\xae\xcb\xf2\x74\x3a\x15\x6a\x36\x5c\x58\xdf\x97\xfa\x02\x0d\xe5\x43\x75\x7b\xbf\xab\xef\x7f\xb8\x2a\x36\x4b\xd3\x47\xa3\x29\x04\x78\xfa\x7b\x62\x4f\x2d\x8e\x67\x28\xe7\x34\x37\xea\xa8\x09\x5a\x9d\x60\x3d\x54\xef\x89\x5a\x44\x9b\x4c\x9f\x3c\x47\x36\xfd\x1a\xc1\x76\xf1\xa4\x3c\x25\x9a\x96\x43\xf4\x7c\x9c\xe2\x9b\x99\xbd\x58\xe4\xf0\x06\x60\x0d\x94\x81\xdc\xd6\xa8\x6a\x89\x9f\xb7\x75\x55\xaf\x13\xc9\xa7\xea\xf0\xdb\xfe\xe3\x01\x9f\xb6\x8f\x8f\xdb\xdd\xa1\xba\xaf\xb1\x7f\xc4\xed\x7e\x77\x57\x1d\xaa\xfd\xae\xc6\xfe\x17\x6c\x77\x9f\xf1\x7b\xb5\xbb\x5b\x83\x38\x0e\xe4\x41\xcf\xce\xa7\x04\xd6\x83\xd3\x34\xa9\x9d\x47\x57\x13\xbd\xb1\xd0\xd9\x8b\xa5\xe0\xa8\xe1\x8e\x1b\x68\x65\xfa\x49\xf5\x84\xde\x3e\x91\x37\x6c\x7a\x38\xf2\x23\x87\xb4\xd5\x00\x65\xda\x44\xa3\x79\xe4\xa8\xe2\x5c\xfa\x5f\xae\x42\x24\xc8\xf7\xc7\x89\x75\x8b\x77\xc6\xb6\x24\x84\x53\xcd\x97\xc4\x3a\x2a\x36\x42\xf0\xe8\xac\x8f\xc8\xc4\x4a\x76\x5a\xf5\x52\xac\xa4\xb6\xf3\x61\x28\x2e\x47\x99\x76\x26\x45\x2e\xc4\x93\xf2\x50\x6d\x3b\xc7\xb9\x41\x6a\x28\xea\xe8\xd9\xf4\x99\x4c\x65\xb9\x86\xbc\x7e\xbf\x79\xbf\x49\x3f\x83\x0d\x31\x99\xc4\x2c\x60\xa6\xf1\x48\x5e\xe6\xb3\xa1\x83\xfa\x42\xfb\x27\xf2\xd0\x1c\x22\x5d\xb2\x20\x90\x7f\x22\x1f\xe6\x07\x52\xdc\x51\xa7\x26\x1d\xeb\x54\xfb\x63\x7a\x4e\x0b\x49\xb1\x5e\xb4\x9d\x0a\x61\x79\x0a\x89\xaf\xb1\xe3\x98\x28\x34\x1b\xba\xb8\x12\xdd\x64\x9a\x57\x9d\x2c\xc7\x3f\x62\xa5\x39\xac\x41\xde\xe3\xfa\x06\x86\x62\xf1\x30\x8b\x67\x32\x36\x4e\xae\xf1\xdd\xc2\x9d\x8b\x15\x77\x33\xec\xdd\x0d\x0c\xeb\xd4\xb9\x72\xca\x70\x93\x91\xf7\xb9\x58\x7d\x15\x62\xa5\x6d\x5f\xfc\xe9\xd9\x44\x6d\x32\x79\x09\x91\xd6\x63\x8d\x5c\xa7\x4c\xc5\xb6\x6d\x7d\x96\xbf\xcc\x26\xcf\xc5\x2b\xe9\xf5\xcd\x25\xe0\x9c\x2c\x9b\x2d\x19\xd6\xf9\x87\x6f\x2b\x7e\x15\xff\x06\x00\x00\xff\xff\xf8\x56\x69\x7b\x14\x04\x00\x00")
-
-func nodegoNodego_localGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoNodego_localGo,
-		"nodego/nodego_local.go",
-	)
-}
-
-func nodegoNodego_localGo() (*asset, error) {
-	bytes, err := nodegoNodego_localGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/nodego_local.go", size: 1044, mode: os.FileMode(436), modTime: time.Unix(1526756142, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoPubsubGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\xdf\x8f\xdb\x36\x0c\x7e\xb6\xff\x0a\x4e\x0f\x85\xdd\xe5\xa4\xdb\xab\x81\x00\x6b\x73\x1d\x7a\x03\xb6\x06\xbd\x00\x03\x16\x04\xab\x62\x33\x8e\xdb\x48\xf2\x24\x3a\x17\xe3\x90\xff\x7d\xd0\x8f\xcb\x39\xed\x80\xa1\xcb\x43\x64\x93\xfc\x3e\x7e\xa4\x4c\x0a\x01\x3f\x6e\x87\xee\xd0\x40\x3f\x6c\xdd\xb0\xcd\xf3\x5e\xd6\x5f\x64\x8b\xa0\x64\xa7\xf3\xbc\x53\xbd\xb1\x04\x45\x9e\xb1\xda\x68\xc2\x13\xb1\x3c\x63\xa8\x6b\xd3\x74\xba\x15\x9f\x9d\xd1\xde\xa0\x91\xc4\x9e\xa8\x67\xb9\x0f\x3c\x98\xa1\xe1\xad\x31\xed\x01\x79\x6d\x94\x68\x8d\x88\xec\x2c\x2f\xf3\x9c\xc6\x1e\x61\x39\x6c\x1f\x86\xed\x2f\x83\xae\x61\x37\xe8\xba\xa8\xe9\x04\x29\x01\x5f\xc4\x73\x06\x0a\x5e\x47\x1c\xff\x0d\x9d\x93\x2d\x96\x80\xd6\x1a\x9b\xe7\x1e\x03\xef\xa5\x6e\x0e\x18\x99\x8a\x9d\xae\x27\xa4\x25\x3c\xe5\x99\x17\xc4\x63\x90\xb7\x15\x4c\xb0\x59\xcc\xf6\x08\xc1\xf7\x11\x5d\x6f\xb4\xc3\x3f\x6c\x47\x68\x67\x60\xe1\x75\xb2\xff\x3d\xa0\xa3\x40\x92\x35\xb8\x43\x0b\x96\xbf\x35\xcd\xc8\x17\x07\xe3\xb0\x28\xf3\x2c\x13\x02\x04\x9e\xb0\x1e\x08\xc5\x5f\x72\x2f\xfa\xc1\xed\x6f\xf6\x21\x99\x75\xa9\x5c\xd1\x5b\xf3\x19\x6b\x72\x62\xbd\xfc\xf8\xe1\xd7\x77\x8b\xd5\x46\x90\xe9\xbb\xda\x89\xf5\xea\xc3\xf2\x7e\xb1\x89\x44\x4f\xf1\xc8\x5e\x7a\x5c\x5d\x4c\x19\xc3\x23\x6a\xba\x6f\x58\xc5\xd6\xf7\x77\x1b\x36\x7b\xf1\x50\xa7\xd0\x91\x54\x3d\xab\xd8\x38\x8e\xe3\x8d\x52\x37\x4d\xb3\xda\xef\x2b\xa5\x2a\xe7\xf8\xed\xed\xed\x9f\xd3\xf8\xc0\xb4\x1a\x7b\x64\x15\x4b\xd7\x93\xfa\x1b\x54\xf9\x97\x43\xe7\xf6\x53\x88\x45\x67\x06\x5b\xe3\x54\x51\xc6\x1c\xda\x63\xe7\x8d\x2c\xe1\x23\x9b\xec\x3b\xe7\x2f\x7c\x42\x90\x31\x2d\x55\x08\xfc\xaf\x56\x5c\x81\x28\x8a\xf4\xc7\x57\xdc\xe2\x5a\xf8\xf1\x27\xbe\x0c\x4f\xe9\x0b\x61\x17\x92\xf3\xf3\xd3\xf9\x42\xcc\x1a\x49\xf2\xaa\xb7\x3f\xff\xff\x44\x93\x26\x49\x22\xdb\x6d\x07\x42\xc7\xaa\x27\xce\xf9\x79\x96\x32\x31\xce\xf9\x45\x51\x12\xe4\x8f\xa3\xb4\xa0\xc0\x91\x1d\x6a\x0a\xb7\x9f\x2d\xe8\x04\x90\x3e\x7c\xf8\xe4\xc7\xaa\xba\x7c\x0c\x9f\x7c\xc0\x9d\x24\x79\x85\xc8\xfc\x45\x7a\x4b\xa7\x5b\x98\xfc\x12\x38\x56\x16\xa0\xd9\x1b\x22\x0b\x4a\xf6\xeb\x18\xbd\x49\xa0\x14\x39\x51\x1f\xc3\x43\xaa\xf5\x66\x3b\x12\xfe\x0b\x71\xa8\x2c\x04\x9e\xbf\x31\xf9\xda\xd0\x5a\xa8\xe6\xe0\x3d\xfc\x77\x7c\xbc\xc3\xda\x34\x68\x8b\x38\x40\x25\x8f\xef\xc5\x2b\xe5\x87\xa8\xdb\xf9\x81\x86\x1f\xe6\xa0\xbb\x43\x2c\xeb\x91\x87\x69\x7c\x8f\xd2\xa3\xc2\x38\x3e\x90\xa4\xc1\xbd\x95\xcd\xf3\x5c\x4e\xe2\x8a\xa8\xb3\x40\x6b\xf9\x3b\xbf\x1b\x8a\xb2\x0c\x7e\x8b\x34\x58\x9d\x34\x29\xd7\x7a\x4d\xaf\xae\xb7\x49\xc8\xf7\xe6\x52\x7d\x05\x8a\xfb\xd2\xb9\x37\xcd\x9e\x7b\x5e\xa5\xea\x93\xcf\xff\x05\x9f\x10\xcb\x38\x2d\xab\x4e\xa1\x87\x2e\xe8\xc4\x57\x0f\xb3\x94\xb1\xdb\x81\x72\x2d\x7f\x61\x87\xf9\xa4\xc8\xaf\x5d\xa0\xe4\x17\x2c\xbe\xb9\xa1\x32\x91\xf9\xe5\x58\xcd\xc1\x3e\x6f\xc6\xb0\x80\x7c\xe3\xe6\xb0\x8b\xbb\x73\xe6\xb3\x7d\x6f\x47\xef\x35\xa1\xd5\xf2\xf0\x80\xf6\x88\x36\x74\xef\xbb\x5b\x7b\x2e\xf3\x73\xfe\x4f\x00\x00\x00\xff\xff\x00\xd8\x90\xb8\x45\x06\x00\x00")
-
-func nodegoPubsubGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoPubsubGo,
-		"nodego/pubsub.go",
-	)
-}
-
-func nodegoPubsubGo() (*asset, error) {
-	bytes, err := nodegoPubsubGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/pubsub.go", size: 1605, mode: os.FileMode(436), modTime: time.Unix(1526808568, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoStorageGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\x51\x6f\xdb\x38\x12\x7e\xb6\x7e\xc5\x9c\x0e\x57\x48\x5d\x5b\x4a\xed\x24\xb8\x13\xd6\x0f\x89\xe3\xbd\x14\x69\xbb\x45\x9c\xde\xe1\x2e\x08\xba\xb4\x34\x96\xd9\x4a\xa4\x96\xa4\x12\x7b\x8b\xfc\xf7\xc3\x90\x94\x25\x67\x0b\xec\xad\x5f\x44\x7f\xf3\xcd\xf0\xe3\xcc\x70\xa4\x34\x85\x1f\xd6\x2d\xaf\x0a\xd0\x46\x2a\x56\x62\x10\x34\x2c\xff\xca\x4a\x84\x9a\x71\x11\x04\xbc\x6e\xa4\x32\x10\x05\xa3\x30\x97\xc2\xe0\xce\x84\xc1\x28\x44\x91\xcb\x82\x8b\x32\x5d\x33\x8d\xe7\xa7\x47\xd0\x17\x2d\x05\x01\x9b\xda\x52\x05\x9a\x74\x6b\x4c\x43\x6b\xc3\x6b\x0c\x03\x0a\x55\xc9\xb6\x48\x4a\x29\xcb\x0a\x93\x5c\xd6\x69\x29\x53\x2f\x20\x0c\x46\x8a\x3d\x41\xe8\x8d\xa5\xac\x98\x28\x13\xa9\xca\x94\x35\xbc\x23\xa5\x8f\x6f\xc2\x20\x0e\x02\xb3\x6f\x10\x56\x0e\xfb\xa9\x15\x39\x6c\x5a\x91\x47\xb9\xd9\x81\x17\x9b\x2c\xdc\x73\x0c\xcc\x18\xa5\xe1\xb5\x0f\x90\xfc\xbc\xfe\x82\xb9\xb9\x20\x30\x06\x54\x4a\xaa\x20\x20\x67\xb8\x66\xa2\xa8\xd0\xc7\x8c\x36\x22\x1f\xc6\x8f\xe1\x5b\x30\xa2\xd3\x24\x8e\x46\x58\x14\xa6\xe1\xd8\x6d\xfc\x04\xd6\x76\x8b\xba\x91\x42\xe3\xbf\x15\x37\xa8\xc6\xa0\xe0\xb5\xc7\x7f\x6d\x51\x1b\x1b\x64\x54\xe0\x06\x15\xa8\xe4\x52\x16\xfb\x64\x51\x49\x8d\x51\x1c\x8c\x46\x69\x0a\x29\xee\x30\x6f\x0d\xa6\x9f\xd9\x36\x6d\x5a\xbd\x9d\x6c\xed\x66\x4a\xa7\x4d\xbb\xd6\xed\x3a\x6d\x94\x24\xf5\x3a\xdd\xed\x76\xbb\xd4\xc8\x86\xe7\x3a\xb5\x39\x9d\x90\x0e\xc3\xa5\xd0\x93\xfd\x7e\xbf\x77\x01\xbf\xb9\xc7\xa8\x2f\x61\x76\x80\x46\x21\x3e\xa2\x30\x6f\x8b\x30\x0b\xef\xdf\x5e\x3d\x84\xe3\xde\x42\xe5\xd2\x86\xd5\x4d\x98\x85\x14\x6d\x52\xd7\x93\xa2\xb8\xdb\x6e\xb3\xba\xce\xb4\x4e\x4e\x4e\x4e\xfe\x3b\xe4\xdb\x48\x77\xfb\x06\xc3\xac\x2b\x5f\x97\x6e\x69\xd3\x9d\xdc\x2f\xff\xb5\xfc\x70\xf7\xf9\xee\x3f\x1f\x97\x47\x3b\x29\xd4\xb2\x55\x39\x0e\x85\x8d\x42\x8d\xea\x91\x13\x18\x76\x61\x5c\x54\xd6\x70\x4d\x5d\x33\x88\x30\x0a\x05\xab\x89\x79\x48\xcd\xe7\x74\xdd\xe6\x5f\xd1\xe8\xf4\xfe\xf2\xd3\xe2\x66\x79\xf7\x90\x3a\x11\x3a\xbd\xff\x78\x71\x77\xfd\x70\xe4\x6d\x9c\x6a\xbf\xcf\x5f\x1d\x33\x3c\x10\x9e\xbb\xd5\xf3\xc1\x29\x2c\x98\x61\x61\xf6\x2d\x49\x12\x6f\xa5\xc7\x23\x53\x50\x83\x36\xaa\xcd\x8d\xcd\xfb\x68\x61\x76\x00\xbe\x0b\x01\x00\x7e\xa1\xfb\x91\x1d\x2a\xf1\x0b\x71\xae\x98\x61\xa0\xd8\x93\xef\xca\x8e\x63\x77\x20\x02\x45\x46\xa5\x20\x9b\x03\x59\x92\x0f\xf8\x74\x85\xb9\x2c\x50\x45\xae\x81\xe2\xc4\xfd\x8f\x5e\xd5\xd4\x44\x7c\x43\x2d\x0d\x7f\x99\x83\xe0\x95\x93\xf1\x94\xd8\x6e\xbc\x46\x46\x5e\xb6\x1d\x57\x86\x99\x56\x5f\xb2\xa2\xeb\xcb\x01\x2f\xba\x7f\x58\xef\x0d\x46\xa8\x54\xb2\xa4\xdb\x11\xc5\xb1\xb5\x2b\x34\xad\x12\x5e\x93\x5c\x7f\x21\x4d\x02\x9f\x9c\xf0\xe8\x55\x9d\xd0\x59\x88\x49\x97\x30\x9b\x83\xea\x6e\xa0\xed\x6e\x52\x35\x87\x8d\xbb\xa3\x63\x90\xeb\x2f\x7f\x56\xee\x5b\x61\x50\x09\x56\xad\x50\x3d\xa2\xb2\xd2\xfe\xb4\xee\xe7\x38\x78\xf6\xb7\xbd\x97\x2e\xe1\x75\x5f\x81\xf8\xbb\x83\x82\xa4\xf1\x0d\x48\x98\xf7\x4a\x5d\x5c\xfa\x1b\x50\x68\x96\x57\x74\xea\x9a\x7d\x25\x29\x5d\x8c\x8b\xc5\xbb\xdb\xb6\xc2\x31\x54\x28\x22\x99\x5c\xe4\x15\x89\xda\x48\x05\x7c\x0c\xaa\xad\xd0\x66\x8a\x89\x12\xc1\x5a\x6d\x64\x96\x57\xf7\xfc\x01\xe6\xf0\x22\x8a\xcd\xcf\x52\x18\x6e\xf6\xd9\xd0\xe6\xa0\x88\xc2\x25\x6e\x1d\x53\xb3\x8e\x6e\x65\x85\x19\xc0\x51\x18\x59\xa1\x23\xd2\xca\xd2\x9e\xad\x7c\xf9\x24\xd0\xb6\x59\x18\xba\xa3\x26\x3f\x5b\x64\x50\x1a\x47\x99\x77\x26\xbf\x95\xf5\xae\x8b\xb3\x31\x7c\x26\x77\xf7\x5a\x48\x56\xa6\x58\xfa\xd7\x82\x6f\xd0\x95\x51\x5c\x94\x91\x4c\xde\x17\x67\xd7\x4c\x6f\xe3\x60\x94\xab\x7c\x36\xcd\xbd\x63\x61\x59\x9f\xb8\x30\xb3\x69\x24\x93\x85\xb5\xc5\x81\xbd\x57\x7a\xcb\xa6\x67\xe7\x74\xb9\xb8\x28\xbd\xbc\x45\xab\x8d\xac\x51\x2d\x45\xae\xf6\x0d\x0d\xbe\xa1\x56\xef\x31\xff\x2e\x31\xb9\xc1\xfd\xca\x12\xac\x78\x5f\xc8\x57\xdf\xa9\x3b\x85\xba\xb4\xc3\x84\xf2\xd8\xff\x64\xe2\x50\xca\xdf\x07\x56\xe3\xb1\x95\xec\x84\x92\xd5\xde\x01\x37\x1b\xb3\xde\x3a\x40\x07\xa4\x77\x4c\x94\x2d\x2b\x1d\xf1\x40\xea\x50\x4b\x64\xf9\x16\x09\x57\xb2\xca\xfa\x68\x03\x94\x58\x17\x8b\x77\x2f\x05\x01\xcb\xad\xc9\x56\xee\x85\xd1\xd6\x75\x20\xa3\x2b\xdc\xb1\x8c\x0e\x1d\x10\xaf\xb8\x6e\xa4\xe6\x94\xd2\xac\x27\x0e\x50\xe2\xae\xf8\x6f\xbf\x4b\x0f\x17\xe6\xfc\x34\x92\x09\xd9\x6c\x0f\xbe\xbf\x3a\xfb\x9d\x62\xea\x29\xda\xeb\x76\x31\x9b\x2e\x8e\xad\xbe\x71\xc8\x11\x0b\xce\xde\x71\xf1\x35\x1b\x26\xff\x80\x3a\x8a\x61\x34\x4f\x33\x38\xa6\x38\x94\x18\xff\x44\x81\x8a\xb9\x63\xf4\x8c\x1e\xed\xa2\x94\x2f\x78\x2e\x4a\x79\xc4\xf3\x9f\x0c\x8b\x8a\x69\xdd\x57\x68\x88\xda\x43\xf9\x9e\xa4\x46\xbc\xbe\x98\x9e\x9d\x67\xe0\x9b\x9c\xac\x37\xef\x57\x37\xb8\x3f\xee\x2b\x99\xdc\xd4\xda\xa3\x36\x82\x42\x66\xb0\x38\xca\x4b\x2e\xc5\x23\x2a\x73\xc7\x6b\x8c\x64\x42\x0f\xcf\xb2\x39\xbe\xc2\x0a\xff\xd8\xc3\xb3\xac\xc7\xa7\xa6\xf8\x83\x3d\x3c\x83\xd8\xcf\x34\x59\xd3\x14\xdc\x6d\x07\x06\xad\xbd\xca\x60\xbf\x0e\xb1\x00\x2e\xe0\xd2\xce\x06\x5a\xad\x79\x39\x41\x51\x70\x26\x80\x86\x36\x48\x55\xa0\x4a\xdc\x60\x3e\x1a\x04\xeb\xf3\x53\x7f\xed\x63\x88\x5c\xc4\xb1\xfb\x68\xb3\xdf\x52\x85\xfd\xf3\xff\xcc\x9d\xf5\xf9\x69\x1c\x7c\xe7\x7d\xe3\x2f\xff\x89\x0d\x64\xc7\x01\xdf\xd8\x61\x5d\xc4\x44\x3b\x7d\x41\xda\xd4\xc6\xbd\x5d\x36\x51\xf7\xc9\x90\xc1\xdf\x7e\x85\x42\xa2\x06\x21\x8d\x3f\x2e\x30\x98\x4d\x27\x6b\x6e\xe0\x91\x55\x2d\x86\x63\x28\xe2\xe1\xac\x71\x27\x89\x8a\xfb\x93\x87\xf8\xc7\x1f\xa7\xa7\xf0\x43\x0f\xbd\x21\xe8\xcd\xf9\x10\x9a\x12\xf4\xf7\x21\x32\x7b\x88\xc7\xf6\xd5\xe3\x92\x3e\xa8\x4a\xb7\xd6\xc0\x80\x3e\xe3\x28\xdd\xb7\x3f\x2d\x66\xb3\xd9\x3f\x60\x23\x55\xcd\x0c\x18\x69\x2d\xb6\xde\x09\xb9\xbf\xdd\x00\x13\x7b\x97\x57\x90\x79\xde\x2a\x4d\x6e\x0d\x53\x9a\x6e\x3c\x98\x2d\xc2\x6f\xa8\xe4\xc4\x9e\xa6\x77\x06\xae\x41\xf3\x0a\x85\xa9\xf6\xe0\x8e\x86\x85\x2f\xe3\xb0\x51\xcc\xa1\x86\xbd\xeb\x37\x37\xda\x55\x0f\xd9\xd4\x1b\xca\x7a\x18\xba\xb4\xd3\xab\x61\xee\x08\x1f\x99\xd2\x18\xd9\xa5\x3f\xcd\x18\xcc\x51\x4e\x55\xf0\x1c\xfc\x2f\x00\x00\xff\xff\xeb\xfb\x04\xa8\xd5\x0c\x00\x00")
-
-func nodegoStorageGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoStorageGo,
-		"nodego/storage.go",
-	)
-}
-
-func nodegoStorageGo() (*asset, error) {
-	bytes, err := nodegoStorageGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/storage.go", size: 3285, mode: os.FileMode(436), modTime: time.Unix(1526808568, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoSupervisorGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x6f\x73\xdb\x36\xd2\x7f\x4d\x7e\x8a\x2d\x67\xea\x90\x0d\x4d\xc9\x79\x9e\xb6\x53\xb5\xea\x8c\x13\x3b\x8d\xa6\xae\x9d\xb1\x9c\xcb\xf4\x92\xcc\x0d\x4c\xae\x28\x34\x14\xc0\x00\xa0\x65\x5d\xe2\xef\x7e\xb3\x00\x48\x91\x92\xed\xf4\xde\x9c\x5f\x58\x12\xb0\xdc\xfd\xed\x1f\x2c\x7e\xcb\xd1\x08\x5e\xc8\x7a\xa3\x78\xb9\x34\xf0\x6c\x7c\xf4\x23\xfc\x26\x65\x59\x21\xcc\x44\x9e\x85\xa3\x51\x38\x1a\xc1\x19\xcf\x51\x68\x2c\xa0\x11\x05\x2a\x30\x4b\x84\xe3\x9a\xe5\x4b\x6c\x77\x52\xf8\x07\x2a\xcd\xa5\x80\x67\xd9\x18\x62\x12\x88\xfc\x56\x94\xfc\x4c\x2a\x36\xb2\x81\x15\xdb\x80\x90\x06\x1a\x8d\x60\x96\x5c\xc3\x82\x57\x08\x78\x9b\x63\x6d\x80\x0b\xc8\xe5\xaa\xae\x38\x13\x39\xc2\x9a\x9b\xa5\xb5\xe3\xb5\x10\x12\xf8\xd3\xeb\x90\xd7\x86\x71\x01\x0c\x72\x59\x6f\x40\x2e\xfa\x82\xc0\x8c\x07\x4d\x7f\x4b\x63\xea\xc9\x68\xb4\x5e\xaf\x33\x66\x01\x67\x52\x95\xa3\xca\x89\xea\xd1\xd9\xec\xc5\xe9\xf9\xfc\xf4\xf0\x59\x36\xf6\x0f\xbd\x11\x15\x6a\x0d\x0a\x3f\x35\x5c\x61\x01\xd7\x1b\x60\x75\x5d\xf1\x9c\x5d\x57\x08\x15\x5b\x83\x54\xc0\x4a\x85\x58\x80\x91\x04\x7a\xad\xb8\xe1\xa2\x4c\x41\xcb\x85\x59\x33\x85\xa4\xa6\xe0\xda\x28\x7e\xdd\x98\x41\xcc\x5a\x88\x5c\x0f\x04\xa4\x00\x26\x20\x3a\x9e\xc3\x6c\x1e\xc1\xf3\xe3\xf9\x6c\x9e\x92\x92\xb7\xb3\xab\x57\x17\x6f\xae\xe0\xed\xf1\xe5\xe5\xf1\xf9\xd5\xec\x74\x0e\x17\x97\xf0\xe2\xe2\xfc\x64\x76\x35\xbb\x38\x9f\xc3\xc5\x4b\x38\x3e\xff\x13\x7e\x9f\x9d\x9f\xa4\x80\xdc\x2c\x51\x01\xde\xd6\x8a\x3c\x90\x0a\x38\x45\x13\x0b\x1b\xba\x39\xe2\x00\xc2\x42\x3a\x48\xba\xc6\x9c\x2f\x78\x0e\x15\x13\x65\xc3\x4a\x84\x52\xde\xa0\x12\x5c\x94\x50\xa3\x5a\x71\x4d\x59\xd5\xc0\x44\x41\x6a\x2a\xbe\xe2\x86\x19\xbb\xb4\xe7\x57\x16\x86\x35\xcb\x3f\x92\x92\x15\xe3\x22\x0c\xf9\xaa\x96\xca\x40\x1c\x06\xd1\xf5\xc6\xa0\x8e\xc2\x20\xca\xa5\x30\x78\x6b\xe8\x2b\x8a\x5c\x16\x5c\x94\xa3\xbf\xb4\x14\x76\x41\x29\xa9\xac\xd4\x62\x65\x25\x2a\x59\xd2\x87\x40\x33\xa2\x4c\xb6\xdf\x1b\x55\xd1\x57\x69\x45\x55\x23\x0c\x5f\xe1\xa8\xc0\xeb\xc6\x4a\x6b\xa3\x72\x29\x6e\xec\xd7\x8d\xc8\xe9\x93\x04\xa2\x30\x09\x43\xb3\xa9\x11\x2a\x59\x9e\x0a\xa3\x36\xa0\x8d\x6a\x72\x03\x9f\xc3\xe0\x0a\x6f\xcd\x6b\xb6\xa9\x24\x2b\x68\x95\x8b\x32\x0c\xe6\x78\x83\x8a\x9b\x0d\xd5\x51\xbb\x76\xc5\x57\x08\xfe\xaf\x5d\x3b\xbd\xc5\xbc\xa1\x90\xcc\x4e\xda\xb5\xbb\x30\x5c\x34\x22\x87\x18\xe1\xbb\xd6\x5a\x02\xb9\x14\x5a\x56\x78\xd1\x98\xba\x31\x71\x02\xef\x3e\x50\x54\xc8\xfc\x0d\x53\x84\xea\x79\xb3\x00\x1b\xa8\xec\x79\xb3\x58\xa0\x0a\x83\xc5\xca\x64\x2f\x6b\xc5\x85\x59\xc4\x07\x4e\x22\x85\xe8\xdd\xb7\xfa\x43\x94\x02\x66\x2d\xc4\x77\x93\xa3\x0f\xc9\x57\xa4\x29\x06\xd9\xb9\x5c\xc7\x49\xf6\x52\xaa\x15\x33\x31\xd7\x92\xfc\x71\xbf\x92\x24\x0c\xf8\x02\x30\xeb\xbb\xf3\xcd\x14\xa2\x88\x00\x7e\x0d\x48\xef\xa1\x24\x0c\xee\xc2\xc0\x89\x64\x6f\x15\x37\xf8\x7c\x63\x30\x7e\x02\x4f\x92\xe1\xf2\xdc\xc6\x2a\xc6\xac\x17\x7c\x07\xa2\x42\xb1\xb3\x0c\xd3\x29\x8c\xe1\xcb\x17\x18\x2c\xbf\xdb\x17\x3c\x3c\xfa\x40\xa8\x9f\xbc\x17\x4f\x2c\xee\x7d\x1c\xef\xc5\x13\x07\x51\xa1\x69\x94\xf0\x71\xcf\x68\x53\xc7\x09\xa5\xae\x2d\x92\xe7\xcc\xe4\xcb\x5e\x91\x50\x1a\x39\x6a\x78\xf7\xa1\x4b\x6a\x18\x06\xb5\xb3\x7c\x86\xa2\x34\x4b\xe0\xc2\x90\x66\x56\x6c\xda\x32\x81\x7c\xc9\x84\xd7\xf2\xf9\x8e\xf4\x8f\x46\xc0\x8a\xc2\x95\x20\x2b\x0a\x0d\x8c\xac\x01\xda\x05\x23\xed\x91\xba\x26\xdb\x6d\xfb\x3d\x97\x06\x27\xdb\x67\xb8\xb6\x5d\xd4\x2c\xc9\x0e\x68\xb6\xc0\xcc\x97\xdb\xb5\x2d\x37\x8b\x3b\xe9\xe4\x63\xa7\xb8\x57\x88\x9f\x6d\x94\xaf\xb3\xd6\xa1\xe9\x14\x04\xaf\x6c\xbc\xf2\x4a\x6a\x8c\xaf\x33\xeb\x82\x8d\x53\x18\xf4\x04\xa9\x19\xa2\x28\xe2\x6e\x29\x75\xb0\x13\x92\x1a\x46\xe2\xe9\xd4\xe5\x91\xb6\x87\x29\xee\x4e\xc7\x00\xae\x42\x6a\x16\x71\x02\xb6\x0b\x78\x8c\xa4\xa1\xb3\xe5\xab\x80\x60\xfa\xdc\x09\x5e\x39\x88\x54\xb9\x4a\xc1\x64\x0a\xb5\xd4\xe6\x4a\xce\x9b\x1a\xd5\x0d\xd7\x52\xc5\xd1\xe8\x5f\x6c\x39\xa2\x4e\x92\xc2\x75\x0a\xba\xdb\x39\x93\x25\x95\xbf\x6c\x4c\xf2\xb3\x7d\xfa\x9b\x6d\x18\xbc\x7e\x54\xca\xe9\xef\xd9\xeb\x15\x48\xc9\x45\xf9\xc2\xf5\xb3\x5e\x99\x70\xc1\xcd\x05\xdd\x61\xd4\x7d\x32\xfa\x16\x86\xc1\xa7\x06\x1b\xfc\xa3\x31\x78\x4b\x9d\x83\x36\xec\x0f\xbf\x31\x28\x95\x2e\x26\x61\x90\x37\x4a\xa1\x30\xae\x10\xb7\xeb\x61\x80\xb7\x98\xcf\x4e\x9c\x3e\xab\xed\xf2\xad\xd7\xe7\x76\x06\x1d\xca\x95\x9c\x36\x4c\x99\x73\x5c\x3b\x65\xb5\xc2\x9a\x29\xa4\xda\x13\xb8\xbe\xaf\xdc\x86\xf2\x0f\xd7\x5c\x6e\x81\xf5\x42\x91\x0c\x1f\x8d\x93\x2d\x72\x0a\x4f\x9e\x0d\xbc\x9a\xc2\x41\xbb\xeb\x02\xcf\x8a\xcd\x04\x56\xec\x23\xc6\x83\x83\x93\xa4\xf6\xd0\xe6\x99\x0b\xd8\x2f\x87\x30\x54\xd4\xe5\x68\x67\xb9\x2b\xb6\xfb\x70\xa2\xe9\x35\xae\x98\xb7\xbd\x3f\x71\x38\x7b\x41\xce\xce\x64\xfe\x31\x4e\xb6\xab\x30\x05\x5e\xec\x0a\xbd\x11\x95\x13\x7b\xcc\x28\xf6\x2c\x26\xde\xe0\xbe\xbd\xcb\xd6\x60\x81\x0b\x54\xb0\xb3\xd9\x19\xda\x3a\xed\xf6\x1f\xb5\xfc\x70\x43\xb8\x96\xb2\xf2\x27\xae\x0d\xf0\x74\xef\x30\x2c\x58\xa5\xd1\x1d\x07\x2f\x34\x0c\x4d\x8b\xb4\xb7\xd5\xe1\x0c\x03\xe2\x20\x54\x16\xfd\x82\x03\xee\xa8\x9b\x4f\x18\x48\x81\xb0\x96\x4d\x55\x40\xa9\xe4\x1a\x8c\x94\xb0\x6a\xa8\x2e\xdb\x56\x30\xcc\xed\xb6\x2f\xfc\x0a\x63\x38\x38\x08\x83\x20\x7e\x44\xea\xe9\x11\xfc\x0a\x2b\x76\x7b\xe6\xcb\xad\x6d\x6a\x5f\xbe\x84\x41\xb0\x53\x96\xc3\x5e\xf6\xf4\xfe\x46\x36\x54\xe7\x44\x13\xd7\x45\xb3\x9d\x23\xd0\x86\x6d\x60\x63\x98\x8f\x64\xdb\x66\x8c\x6a\xf0\xf1\xc2\x25\xed\x97\xb6\x61\xbe\x95\xea\x23\xaa\xd8\xda\x25\x52\xd7\x1d\xb5\xc9\x14\x14\x13\x25\x76\x19\x25\x60\xbf\x1c\xb6\xfb\xae\xc3\x87\x16\xec\x3d\xc9\xb4\x11\x6f\x55\x4d\xa7\x3b\xa7\xca\xea\xba\xcf\x4b\x3a\xa2\xc1\x43\x25\xd0\xeb\xd2\x3d\x14\xae\xeb\xef\x75\xe0\x1e\xe3\xa8\x44\x2c\x75\x36\x37\x05\x2a\x95\x92\x5c\x76\x4a\x57\x44\x4c\x94\x25\x08\x3e\xf2\xaa\x9a\x09\x6d\x68\x6c\x68\x21\xdc\x3d\x1a\x3d\x6a\xd0\x9c\x55\xfc\xdf\x18\xfb\x93\xde\xb6\xec\xec\x44\xc6\xf4\x58\xdc\xa6\xd1\x9f\x85\x5e\x3f\xea\x7a\x59\x0a\xdf\x27\xf7\xa7\x3a\x28\x25\xf8\xe5\x61\x8e\xc2\xe0\xce\xf6\x06\x4f\xf7\x2c\x28\x73\xbb\x73\x93\xb8\xed\x98\xee\x91\x85\x3c\x93\xa5\xa5\x2e\x0a\x7a\x17\x97\x5f\x99\x42\x34\x3b\x7f\x79\x11\x85\x81\xbd\x31\xb7\xa2\xf7\x49\x9e\x5e\x5e\x5e\x5c\x46\xee\x1c\xce\x9c\xe2\x12\x15\xb5\x75\x4b\x3e\x4a\xcb\xe4\x99\x71\xe7\x12\x35\x68\x14\x85\xa6\x1d\xdd\x32\x92\xad\x56\x37\x9c\x31\xab\x4b\xb7\x24\xb9\xc2\x1b\xac\x68\x16\x23\x4c\x59\x18\xf4\x8c\xd8\x74\x67\xe7\xb8\x8e\x07\x2e\xa5\x10\x45\x29\x8c\x93\xff\x01\x26\xeb\x7d\x16\x06\xa7\x3e\x50\x43\x54\xc3\xf0\x75\xb0\x12\x5f\x42\x54\x1c\x71\x4b\x9a\xb6\x16\x5f\x49\x6d\x04\x5b\xa1\x27\xc9\x07\x07\xbd\xbd\x99\x30\xa8\x04\xab\x5e\xd3\xf8\xb3\x25\xd1\xdb\x9c\x67\xfd\x1a\xf4\xf5\x4a\xe3\x81\x81\x01\x29\xa7\xcc\x3d\x1b\x8f\x7f\x38\x1c\x1f\x1d\x8e\x9f\x5d\x1d\x7d\x3f\x19\xff\xff\x64\xfc\x7d\xf6\xd3\x4f\x3f\xfd\x73\xfc\xe3\x64\x3c\x8e\x3c\x19\xd9\x4b\xb9\xbf\xfa\xed\x18\x49\x2b\x76\x18\xc4\x15\x0a\xa3\x81\x4b\xc7\x87\x95\xfb\x68\x2f\xf2\xf5\x9e\x96\xc4\x3d\x1b\xd7\x7e\x54\x49\x20\xe6\xc2\xa4\x8e\xa1\xd9\x88\xb8\x9b\x64\xe2\x2e\x71\xdb\xcc\xc8\xcf\x5e\x93\x9c\x78\x24\x71\x4d\x17\x78\x37\x53\x4d\xb6\xf4\x24\x5e\xdb\x1d\x72\x7a\xd2\xf2\xa0\xaf\xcd\x2a\xf4\x40\xef\xe6\x9e\xf4\x4e\x53\x36\xb8\x5f\xd3\x8e\x1e\x7e\xd3\x13\xd9\x69\xbc\xfd\x4b\xae\x6b\x34\x2e\x36\xbe\xeb\xef\x4c\x6e\xc9\x80\x14\xde\x7f\x39\xa4\x2d\x59\xb4\xc1\x15\xb8\xde\x52\xd2\x4b\xfc\xd4\xa0\x36\x71\xcd\xcc\xd2\x07\x21\x85\x1b\x1a\x1d\x50\x2d\x58\x8e\x9f\xef\x12\x88\xbf\xa3\x81\x37\xf3\xa2\xfd\x90\x13\xc1\x3d\x61\x86\xa5\x6d\x2f\xa5\xe9\x39\xfb\x83\x29\xbd\x64\x55\x7c\x93\x74\x64\x78\x9f\xce\x0a\x5e\xa5\x7d\x4e\xfb\xa9\xd3\x61\x8d\x9d\xe3\xba\x85\x16\xbd\xbe\x98\x5f\x45\x29\xc4\x07\x8d\xaa\xb2\x37\x97\x67\xa4\x65\x9e\x2f\x91\x92\x14\xd9\x59\x9c\x72\x40\x67\x80\x92\x76\xcf\xa9\x78\x0a\xd1\x24\x82\xa7\x0f\x1c\x0a\x7a\xf8\x35\x33\x4b\x7a\x98\xc2\x40\x79\x4a\x32\x3f\x11\x26\xa9\x9f\x82\xa9\xa9\xda\x41\x38\x6e\x9d\x4e\xfe\x2b\xf7\xb2\x57\xc8\x0a\x54\xd9\x71\x51\xc4\x91\x6d\xaf\xc2\x1c\x5e\x6d\x6a\x8c\x52\x88\xfc\x6b\x1d\xaa\x14\xf7\x02\x22\x79\xf8\x19\x77\xb7\x47\x29\xf8\x77\x0b\xd9\xcc\x48\x66\xb9\xc6\x16\x58\xef\xfe\xb6\x91\xed\xa7\xbf\x90\x3e\xb0\x6f\xb9\x59\xfa\x3e\x1f\xe7\xe6\x16\xfc\xdb\x90\xcc\xaf\xa5\xa0\x60\x90\xf8\x5e\x21\xe8\x5a\xda\x17\x6d\xdb\x4a\x50\xa8\xeb\x61\x06\x4f\x70\xc1\x9a\xca\xbc\xa8\x38\x0a\x43\x97\x99\xca\x76\x2c\xde\x1f\x40\x8d\x15\xba\xe1\x25\xc8\x99\x26\x76\x9d\x9b\xdb\xec\x44\x0a\x8c\x93\x09\x5d\xb2\x24\x3f\x05\x5a\x3c\x55\xf6\x22\x23\xb6\x47\xa6\x26\xed\x85\xbb\xf5\xdd\x83\xea\x9c\xdf\x9b\xc8\x1e\xae\x7b\xf7\x96\x42\x36\xc6\x75\x80\x93\x46\xd9\xfc\xf4\x66\xc2\xdc\xdc\xa6\x90\xd3\x5d\x5f\x91\xd7\x6d\xfc\xc8\x4b\x3f\xcc\xc5\xed\xda\x73\x96\x7f\x2c\x95\x6c\x44\x41\x15\xe5\x15\x6f\x79\xaa\xd5\x11\x27\x3b\x07\xe1\xc1\x83\x9a\xc2\xa3\x47\xab\x57\x76\xbd\xa4\x3c\x94\xf7\x94\x4a\xe4\x5e\x75\x7e\x65\xda\x0b\xb6\xa3\x43\x5b\x3b\x52\xd9\x93\x11\x47\x6d\xac\xd6\x4b\x14\x90\xb3\xaa\xa2\x49\x62\x7b\xde\xa2\x96\x8f\xb5\xfc\x7d\x65\x1c\x71\x5a\xc4\xee\x4d\xdb\x43\x0f\x4e\xe0\x5b\xfd\x5e\xbc\x17\xf4\x3f\x1a\x10\xae\x14\xec\x5b\xb6\x6c\x6e\x18\x71\xba\xa4\xeb\xae\xe4\x35\xad\x9a\x46\xbf\x90\x05\xc2\x2f\xf0\x6c\x6c\xdf\xd7\xec\x6e\xfc\x3a\x85\xff\x1b\xbb\x19\xde\xd5\x54\x1f\x15\x17\xb9\x54\x8a\x2a\x51\xf9\x72\x87\x9c\x1e\x5a\x28\xb9\x1a\xe2\x2b\x2c\xb2\x1d\xe5\xc3\xb6\xdc\x2f\xc1\x21\x47\xb4\x17\xd7\xa3\x6f\x0b\x48\x3e\x4a\x5d\x43\xd9\xda\xfd\x9d\x57\xd5\x55\x57\x46\xfb\xb9\xfb\x7b\x8c\xf5\xce\x72\x94\xd3\x5b\x6e\x9c\x73\x47\x3f\x10\xe3\xc9\x65\xcd\xb1\x00\x79\x83\xca\xb9\xbb\xb6\x9c\x31\xfb\x4b\x67\x61\x20\x75\x46\xf2\xf1\xd1\x0f\xdb\xd1\xd2\x11\xa4\x3f\x78\x51\x54\xb8\x66\x0a\xe3\x25\x13\x45\x85\xca\x35\x82\x57\xee\x47\x32\xf8\xf5\x92\x9e\xfb\xdc\x05\xc8\x12\xdd\x35\x0c\xda\x4b\x4b\x81\xf6\xda\xd0\x0e\x7d\xd9\x99\x9c\x55\xdb\x35\x7f\x43\x13\x47\x64\x87\x36\x0e\x3b\x91\xc3\x59\x11\xd9\x06\xe9\x0f\x5f\x8f\x63\x3f\xa2\x35\x72\x15\xec\x66\x07\xef\x5e\x36\x47\x75\x83\xaf\xae\xae\x5e\xc7\xeb\x14\x54\xcb\x9d\x88\xe6\x70\xb3\xf4\xd4\xce\x39\xa8\x81\x89\x81\xff\x8e\x4d\xd2\xdc\xa3\x2d\x7b\x5c\x78\x9c\xdb\x91\x1c\x66\x27\xf6\xcd\x3b\x33\x86\x59\xca\xc9\x0d\x51\xcd\x4a\x96\xb0\x42\xad\x59\xe9\x58\xa8\xd9\xe7\x9f\x9e\x44\x6d\x41\xfc\x8d\x7c\xf4\x72\xf1\x50\x36\x93\x3d\xe7\x6c\x12\xb9\x73\x40\xd3\x45\xcb\x74\xdf\xf5\xeb\xc6\x00\xcb\x73\xac\x0d\xb1\xe8\x16\x43\xeb\xe9\x1e\x4a\xd2\x76\x2f\x52\xda\x78\xb4\x7a\xbe\x86\xf8\xe2\x06\x95\xe2\x05\x7a\x5c\x1a\x8d\xc3\xec\xef\x8d\x96\xe0\x4b\x4b\xa9\xee\xe1\xf3\x7e\x9f\x68\xbd\xcd\xc8\x83\x93\x86\xf5\x68\x68\xcd\x95\x16\xd1\xfb\x39\x1a\x4f\xda\x06\xa3\x47\xd2\xed\xbe\xac\x58\xa9\xe3\x31\xa1\xfe\x4f\x00\x00\x00\xff\xff\xe3\x0c\x66\xc4\x09\x1b\x00\x00")
-
-func nodegoSupervisorGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoSupervisorGo,
-		"nodego/supervisor.go",
-	)
-}
-
-func nodegoSupervisorGo() (*asset, error) {
-	bytes, err := nodegoSupervisorGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/supervisor.go", size: 6921, mode: os.FileMode(436), modTime: time.Unix(1527201943, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nodegoTypesGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x31\xce\xc2\x30\x0c\x85\xe7\x3f\xa7\x78\xea\x31\xfe\x15\x18\x58\x18\xa0\x07\x68\x14\xac\x2a\xa0\xb8\x55\xe2\x56\x20\xd4\xbb\x23\x53\xd3\x0e\x65\x4b\xfc\xbe\x38\xdf\xeb\x7d\xb8\xfb\x96\x90\x7c\x64\xe7\xe4\xd9\x13\x76\x1d\x0b\x3d\x04\x45\xf2\x10\x04\x2f\xf7\x77\x18\x89\xe5\xb8\x07\x74\x16\xb9\x05\xd0\xdc\x4a\xc7\xff\x15\x7d\x92\x6b\xd5\x18\x54\xeb\x82\x9f\x90\x26\x8a\xd5\x31\x51\x11\x9f\xfa\x0d\x26\xdf\x44\xb1\x33\x95\x6e\xc8\x81\x80\xe5\x64\x58\xb6\x7b\xd5\xb8\xc9\x8c\x17\x64\x55\xbe\x50\x1e\x63\x58\x5c\xec\x6d\x99\xa7\xfa\xc1\xc9\x27\xc2\xda\xc8\x00\xf6\x69\xb6\xd4\xb5\x9b\x54\xe6\x0e\x93\x7b\x07\x00\x00\xff\xff\x26\x5f\x8d\x22\x36\x01\x00\x00")
-
-func nodegoTypesGoBytes() ([]byte, error) {
-	return bindataRead(
-		_nodegoTypesGo,
-		"nodego/types.go",
-	)
-}
-
-func nodegoTypesGo() (*asset, error) {
-	bytes, err := nodegoTypesGoBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nodego/types.go", size: 310, mode: os.FileMode(436), modTime: time.Unix(1526808568, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _functionTar = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x0b\x74\x1c\xc5\x9d\x2f\x8e\x97\x5e\xb6\x3c\x06\x4b\xbc\x8c\xc1\x10\x2a\xb2\x6c\x6b\xb0\x66\x34\x92\x6d\xd9\x96\x23\x90\x90\x64\xf7\x80\x24\x6b\x2d\x19\x4c\x16\xd2\x6e\xcd\xf4\xcc\x34\xee\xe9\x1e\xba\x7b\xf4\x20\x0e\x71\x62\x0b\x98\xd5\xea\xae\x93\x0d\x2c\xbb\xb9\x9c\xe5\xe6\xec\x3f\x97\xdd\x4d\xee\x9f\xb3\x1b\x12\xc2\xbd\x0e\x36\x24\xb6\x93\xbd\x49\x9c\x6c\x36\x21\x8f\x0d\x10\x02\x19\x81\x13\x6c\x08\xc6\x10\xac\xfe\x9d\x7a\xcd\x54\xb7\xba\x65\xf9\x11\x92\xbb\x51\x1b\x54\x53\x9f\xaa\xfa\xd6\xfb\x5b\x8f\xae\xfa\xb4\xa6\xc7\x65\x31\xad\xc7\xb3\xaa\x6c\x36\x80\xdf\xcf\x13\x89\x44\xd6\xad\x5b\x0b\x41\x24\xd2\xb8\x6e\x6d\x84\x37\x0b\x0f\x6c\x5c\xdd\xb4\xae\x79\x5d\x24\x12\x69\x6c\x82\x91\xc6\x35\xab\x57\x37\x02\xb8\xf6\xf7\x94\x1e\xc7\x93\x35\x2d\xc9\x00\x91\x48\x5c\xd6\xb4\xe1\x98\xbf\xbf\x33\xb9\xd3\x7c\x14\xcc\xff\x47\x1e\x47\xfd\xcb\x23\x72\x4c\x36\x2e\x78\x33\x98\x75\xfd\x37\x37\xaf\x6e\x6c\x6c\x84\x91\xc6\xb5\xcd\x8d\x6b\xe6\xea\xff\xfd\x78\xbc\xea\x7f\x50\xd1\xe2\x8a\x96\x0c\x27\x47\x33\x17\x24\x8e\x48\x24\xd2\xdc\xbc\xc6\xb7\xfe\x1b\x9b\xd6\xac\xa6\xf5\xbf\x66\xed\x9a\x35\xa8\xfe\xd7\xad\x5b\xbb\x16\xc0\xc8\x05\x89\xfd\x0c\xcf\x9f\x78\xfd\x2f\x83\x1d\x7a\x66\xd4\x50\x92\x29\x0b\x36\x45\x1a\xd7\xc1\xcd\xba\x9e\x54\x65\x18\xd5\x62\xe1\xc0\xb2\xc0\x32\xd8\xad\xc4\x64\xcd\x94\xe3\x30\xab\xc5\x65\x03\x5a\x29\x19\xb6\x67\xa4\x58\x4a\x66\x2e\xf5\xf0\x56\xd9\x30\x15\x5d\x83\x4d\xe1\x08\xac\x43\x1e\x6a\xa8\x53\x4d\x70\x63\x60\x19\x1c\xd5\xb3\x30\x2d\x8d\x42\x4d\xb7\x60\xd6\x94\xa1\x95\x52\x4c\x98\x50\x54\x19\xca\x23\x31\x39\x63\x41\x45\x83\x31\x3d\x9d\x51\x15\x49\x8b\xc9\x70\x58\xb1\x52\x38\x1a\x2a\x24\x1c\x58\x06\x6f\xa7\x22\xf4\x41\x4b\x52\x34\x28\xc1\x98\x9e\x19\x85\x7a\x82\xf7\x07\x25\x0b\x27\x18\x3d\x29\xcb\xca\xb4\x34\x34\x0c\x0f\x0f\x87\x25\x9c\xd8\xb0\x6e\x24\x1b\x54\xe2\xd1\x6c\xe8\x8e\x76\x74\xf5\xf6\x77\x85\x9a\xc2\x11\x1c\x64\x9b\xa6\xca\xa6\x09\x0d\xf9\xee\xac\x62\xc8\x71\x38\x38\x0a\xa5\x4c\x46\x55\x62\xd2\xa0\x2a\x43\x55\x1a\x86\xba\x01\xa5\xa4\x21\xcb\x71\x68\xe9\x28\xbd\xc3\x86\x62\x29\x5a\xb2\x1e\x9a\x7a\xc2\x1a\x96\x0c\x39\xb0\x0c\xc6\x15\xd3\x32\x94\xc1\xac\xe5\x28\x2c\x96\x3a\xc5\x74\x78\xd0\x35\x28\x69\xb0\xa6\xbd\x1f\x46\xfb\x6b\xe0\x4d\xed\xfd\xd1\xfe\xfa\xc0\x32\x78\x5b\x74\x40\xd8\xb2\x6d\x00\xde\xd6\xbe\x75\x6b\x7b\xef\x40\xb4\xab\x1f\x6e\xd9\x0a\x3b\xb6\xf4\x76\x46\x07\xa2\x5b\x7a\xfb\xe1\x96\x4d\xb0\xbd\xf7\x76\x78\x4b\xb4\xb7\xb3\x1e\xca\x8a\x95\x92\x0d\x28\x8f\x64\x0c\x94\x7e\xdd\x80\x0a\x2a\x46\x39\x8e\xca\xac\x5f\x96\x1d\x09\x48\xe8\x24\x41\x66\x46\x8e\x29\x09\x25\x06\x55\x49\x4b\x66\xa5\xa4\x0c\x93\xfa\x90\x6c\x68\x8a\x96\x84\x19\xd9\x48\x2b\x26\xaa\x4c\x13\x4a\x5a\x3c\xb0\x0c\xaa\x4a\x5a\xb1\x24\x0b\x23\xd3\x32\x15\x0e\x04\x3e\x1a\x80\xb0\xc6\x92\x8c\xa4\x6c\x99\x35\x2d\xf0\xcf\x03\xa8\xf8\x3f\x8a\xff\x16\x1c\x44\x4d\x4a\xcb\x35\x2d\xb0\x86\xe8\x97\x9a\x7a\xe6\x6c\xea\x59\x23\x26\xe3\x70\xcc\x31\x1c\x8b\xd5\xc0\x3b\xb1\x87\x8f\x05\x20\xbc\x33\xf0\xb1\xc0\x1f\xba\x8b\xfc\x97\x7e\x3c\xf5\x7f\x56\x51\xe3\x17\x70\x16\x70\xf6\xe3\x7f\xf3\xba\xc6\xd5\x73\xe3\xff\xfb\xf1\xf8\xd7\x7f\x8f\xb4\x53\x46\x5a\xfa\xfc\xe3\x98\x79\xfc\x5f\x1d\x69\x5e\xb3\xd6\x59\xff\x4d\x91\xd5\xcd\xab\xe7\xc6\xff\xf7\xe3\x59\x06\x6f\x93\xe1\xa0\x6e\x18\xfa\x30\x4c\xc9\xd2\x90\xa2\x8e\xc2\x84\xa1\xa7\xb1\x9a\xdf\x29\x1b\x9a\xac\x42\xdc\x1e\xa0\x29\x5b\xd9\x4c\x3d\xb4\x52\x7a\x36\x99\x82\xc3\x32\x94\x0c\x19\x9a\x68\xc0\x91\x0d\x68\x2a\x5a\x0c\x8d\x81\xc3\x32\x8c\xeb\xda\x4a\x0b\xa6\xa4\x21\x19\xde\x12\xd3\xb5\x84\x92\x84\xd6\xb0\x2c\xed\x44\xe3\x8b\x29\x5b\x68\xd8\x34\xd1\xf0\x97\x35\xc3\x81\xc0\x32\x38\x90\x92\xc9\xa8\x15\x53\x2c\x98\x96\x76\xca\xd0\x40\x6d\x91\x08\x50\x2c\xa8\xea\x3a\x0e\x8a\x86\xaf\xad\x1d\xfd\x78\xe2\x60\xd6\x43\x29\xad\x6b\x49\xa8\xe3\xf1\xcf\x4a\x21\x99\x68\xcc\xbb\x4d\x86\x8a\x66\x5a\xb2\x14\x47\x83\x22\x96\xa9\x8e\xe2\xc1\x5a\x86\x92\xaa\xe2\x4c\x11\xf1\xc3\x32\x8c\xa1\x0c\x48\x83\x7a\xd6\x42\x41\xa3\xd6\x4a\x13\xca\x43\xb2\x06\xef\xce\x2a\xb1\x9d\xb2\x01\xeb\x4c\x69\x48\x36\xe1\xbd\x4d\x91\x48\xda\x0c\xa2\x81\x3f\x23\x99\x26\x0c\x19\x28\xf5\x48\x52\x4c\x4f\xa7\x25\x2d\x0e\x55\x45\x93\xc3\x81\x9e\xf6\x5b\xba\x36\x75\xb7\x6f\xee\x6f\x0d\x19\x2c\x63\x64\x80\x83\x71\xc5\x90\x63\x96\x6e\x8c\x42\xcb\x90\xe5\x70\xc0\x34\x62\x71\xc5\x80\x2d\xad\x30\x1c\x0e\x48\x83\xa6\x58\x04\x6a\xeb\xa4\x41\x33\x23\x59\x29\x58\x5b\x47\xd0\x60\x90\x49\x43\xc3\x28\x9b\xf4\xe0\x3a\x89\x2b\x46\x38\xc0\x7e\xe1\x51\x16\xde\xd8\x0a\x0b\xc5\x7a\x6b\x6b\x23\x4c\xa8\x52\x12\xa5\x97\x4f\x2b\x2e\x66\x13\x66\x4d\x38\x24\x1b\x83\xba\x29\xab\xa3\x30\x63\x28\x9a\xe5\xf0\x65\x86\x03\x4a\x22\x2e\x27\xe0\xad\x01\x88\xca\x44\xb6\x5a\x03\xb2\x6a\xca\x05\x1b\xfe\x2b\x06\x64\x2d\xae\x24\x50\x94\xfd\x78\x62\x31\x0a\x6f\xda\x16\xed\xee\x1c\xb8\xbd\xaf\xab\x75\xab\xac\xca\x92\x29\x7b\x95\x17\xae\x4f\x09\x1a\xd4\x07\xce\x44\x38\x50\x08\x8a\xf2\x41\x43\x23\xd1\x9d\x85\x02\x44\xb5\xa8\x67\x0d\xda\x26\xf5\xac\x95\xc9\x5a\x30\xa9\xcb\x26\x54\x34\x4b\x47\x15\xd9\xab\x5b\x68\xe2\x23\x59\x64\xa2\x99\xce\x9a\x16\x1c\x94\xa1\x35\xac\x17\xea\x41\x91\x4d\x38\x28\x6b\x32\x2a\x66\xd3\x88\x35\xe0\xc4\x64\x35\xc5\x82\x96\x6c\x5a\x26\xab\x6a\x34\x21\x93\x4c\x94\xf4\x51\x68\xc8\x52\x2c\x85\xe3\x20\x93\x28\x14\xaa\x58\xad\x28\x7c\x5c\xb2\x24\x32\x79\x35\x64\x55\xb2\x94\x21\x19\xa2\x7a\x34\x8b\x35\x84\x32\x55\x5b\xe7\xa8\xaf\x60\x43\x6d\x5d\x21\xd3\x41\xdc\x16\x0a\xbe\x5d\xad\x81\xe1\xc1\x60\x20\x2e\x67\xcc\x82\x8f\x02\xde\x10\x46\x38\x2a\xae\x2d\x83\x77\xc9\x31\x8b\x95\x4e\x21\x99\xe1\x80\x3e\x78\x97\x3b\x90\x3e\x78\x17\x8e\xb6\xe0\x54\x8c\x51\x1f\xbc\x8b\x34\xbe\xdb\x68\x05\xc1\x6c\x06\x4a\x50\x55\x4c\x0b\x35\x43\x79\x48\x36\x46\x51\xcf\x47\xeb\x05\x5d\x2b\x34\x4d\x3a\x1b\x84\xa6\x4e\x7a\x99\x06\x4d\x35\x6b\x64\xd0\xb4\xd9\x4a\x21\x25\x91\x94\x35\xd9\x90\xd0\x2c\x38\x2e\x67\x64\x2d\x2e\x6b\xb1\x51\xdc\x2d\x21\x1b\x73\x50\x6d\x62\x91\xa8\x1a\xc2\x01\x49\x55\x45\x94\x37\xd8\xd2\x1a\x08\x04\x02\x1d\x1d\x61\x12\x07\x29\xd0\x8e\x8e\x60\xa0\x03\xf7\x3c\x27\x8c\xa1\x60\xa0\x63\xfb\x76\x27\xbe\x7d\x3b\x06\x3d\x42\x50\x30\x88\x7e\xf7\xf5\xd1\xf0\xdd\xd1\xde\x5b\x1c\xde\x10\x10\x0c\x74\x77\x4e\x17\x40\xb1\x60\xa0\x7d\xab\x03\x6f\xdf\x8a\x8b\xb1\x63\xd5\x2a\xb4\xae\x30\xa1\x46\x17\x12\x83\x32\xea\x0b\x3b\xe5\x38\x69\x37\xc9\x55\xab\xc2\x38\xba\x42\x72\xa8\x14\xa2\x01\xb6\x74\x6e\xa9\x93\x87\x24\x2d\xd8\x02\xd3\xfa\x10\x51\x68\x31\x43\x37\xcd\x10\x5a\x3d\x29\x2a\x9e\xa5\x43\x55\x4f\x2a\x31\x24\x3c\x39\x9a\x09\x59\x4a\x5a\xa6\xf5\x40\xd4\x32\x8a\x39\xb0\x0c\x39\x1b\x32\x5e\xe0\x58\x74\x3d\x26\x6b\x43\x8a\xa1\x6b\x69\x59\xb3\x60\x42\x52\xd5\x41\x29\xb6\x13\x55\x02\xd6\xc8\x12\xd2\x97\xaa\x1a\x46\x25\x9f\xd2\x4d\x9c\xab\x64\x2c\xc6\x4a\x9d\x42\xb8\xa4\x0b\xce\xab\x56\x15\x0b\x99\x79\xc0\x45\xc9\x7c\x90\x0c\x22\x5b\xb1\x30\x99\xc7\xf6\xad\x05\x6f\x12\x56\xa6\x9d\x72\x02\x29\x0d\x09\x35\x66\x98\xc8\x6a\x31\x9c\x57\xdc\xcb\x51\x03\x4b\x49\x5a\x5c\x45\x4b\x1b\x29\x26\xe3\x81\x80\x5b\x00\x26\xb5\x2c\x5e\xfd\xb1\x95\x5a\x03\xca\x52\x43\x5a\xd2\xb2\x92\x8a\x7f\x87\x53\x56\x5a\x5d\xd6\x3f\xaa\x59\xd2\x48\x48\x4f\x84\x36\x51\xf1\x66\x60\x19\xac\x51\x65\x29\x8e\x47\x2e\x2c\x1a\x45\x86\x96\xb1\x52\x26\x23\x4b\x06\x6d\xd1\xd0\x92\x47\x2c\xd6\xf8\x13\x8a\x61\x5a\x50\x32\x92\x59\x5c\x94\xa8\xe4\x0c\xc5\xb2\x64\x2d\x4c\x94\xb2\x29\xc3\x58\x4a\x32\xa4\x98\x25\x1b\x58\x1c\x6a\x04\xa8\x97\x16\x54\x4b\x21\xec\x90\xa4\x66\x65\xb4\x16\x1d\x92\x0c\x05\xaf\x44\xcd\xec\xa0\x69\x29\x56\x16\xa5\x2e\x5c\x13\x90\xd3\x19\x6b\x14\xf5\x09\x9c\x38\xd2\x7b\x31\x16\x2c\xfc\x08\x14\xcb\xc2\xb4\xa4\xd8\x4e\xb4\xd2\x4b\xa8\xfa\x70\x38\xa6\xa7\x1b\xee\xce\xca\x26\xce\x68\x43\x63\xe3\xfa\x0d\xeb\xd6\x37\x36\x64\x51\x6f\x0e\xa1\x42\x09\xc5\x15\x23\xa4\x1b\x21\x4d\xb7\xf0\x2f\x2d\x24\x85\x90\x5a\x08\xa1\x96\x1a\x22\xa5\x11\x40\x6d\x48\x8a\xc9\x22\x2d\x1c\x14\x3f\x4e\x22\x32\x11\x14\xac\xbf\xb1\xbe\xb6\x31\x18\xc8\x6a\xbe\x3e\x6f\xac\x2f\xf8\x45\x3e\xe3\x8a\x31\x82\x5d\x63\xa8\x75\xbb\xc3\xd5\xd7\xd6\xa1\xfa\xa7\xae\x6e\xb7\xc6\x20\xd1\x56\x9b\x54\x29\x89\x75\x38\x6e\xbb\xc9\x58\xac\xa0\x08\x8b\xda\x46\xd1\x12\x7a\x18\x72\x63\xc5\xa8\x9e\xe5\xba\x65\x60\x19\x9e\x1d\x24\xb2\x2a\x4c\xc9\x86\x8c\x40\xb2\x73\x21\xe3\x01\xd5\xa4\x2d\x2f\x86\xf7\x41\xd0\x90\x86\x96\xf6\xb1\x18\xae\x4f\xbc\x4a\x36\x2d\x49\x8b\xd3\x19\x09\x99\x7e\x58\x3a\x6a\xbd\x72\x86\xec\x7c\xd0\x11\xd1\x54\xe2\x85\x26\xa3\xc5\x11\xa4\x41\x43\xc6\x03\x39\x1e\xbf\x64\x28\xe3\x55\x78\x41\x95\xae\xb4\x10\x82\xf4\x30\xd6\x18\x12\x1c\x34\xf4\x9d\xb2\x56\x10\x1c\x46\x63\x03\x8e\x01\x15\x22\x1d\x27\xd0\x20\xe3\x59\x62\x6d\xc1\x70\x3c\xd0\xd9\x45\x34\x1d\x6c\x85\xa1\x9e\x9e\x4e\x18\xea\xd9\x44\x82\x22\x31\xc1\xb0\x21\x0d\xd3\x21\x00\xcf\xc8\x2c\x1d\x26\x94\x91\x6c\x06\x27\x0e\xeb\x64\x5a\xb8\x78\x5b\x26\x21\x0f\xc3\x61\x69\x14\x77\xc1\xba\xc6\x20\xed\x11\x28\xbf\xc4\x93\x99\xd2\xb3\x6a\x1c\xa2\xe6\xad\xd0\x22\xc8\x18\x7a\x46\x36\x60\x58\xa7\xe9\x5f\xc6\x8a\x15\x0d\xa9\xa4\x54\x55\x9d\x96\x3d\x1e\x97\x68\x37\x21\x9a\xb1\x9e\x15\x8d\xae\x0d\xc9\x86\x85\x66\x14\x68\x1c\xd1\x13\x48\xc9\xa5\xf0\x2c\x23\xdd\x82\x37\x85\x12\xba\x3e\x28\x19\x61\xbd\x05\x76\x76\xf5\x35\xa2\x3f\x4d\x81\x65\xb8\xd7\x61\x67\x24\xba\xc1\xd2\x1b\xbc\xbd\xd5\x35\x05\x51\x2c\xc3\x92\x66\x41\xbc\x41\x82\x26\xa4\x78\x9c\x42\xda\xc0\xd2\x61\x4c\x42\xed\x23\x8b\x9b\x5d\x42\x52\x54\xdc\x8c\xc8\x9c\x86\xb4\x01\x14\x12\x6b\x5d\xdc\x1a\xfc\x52\x04\xef\xa0\x1b\x58\xc5\xa7\xb3\xab\x6f\x35\xd6\xd8\xd8\x05\xf9\x6c\x61\xbf\x9a\x0a\xbf\x56\xb7\x90\x46\xa2\x24\x0a\x45\x6e\xe2\xf9\x39\x4d\x6c\x3d\x9e\xc4\xac\x34\x64\x78\x17\x9a\x0e\xc5\x74\x0d\xb5\x3c\x43\x8e\xc3\x4c\x4a\xd7\xc8\xe0\xcb\x26\xd0\xac\x9a\xe3\x3a\x34\xf5\x34\xaa\x20\xd9\xb2\x50\x8f\x31\x25\x4d\x86\xb2\x19\x93\x32\x28\xfb\x68\x84\x91\x51\x1b\x45\x95\x83\x46\x0b\x53\x95\xcc\x94\x8c\x94\x26\xee\x10\xba\xaa\x4a\x68\x5d\x90\xd4\x4c\x34\x8a\x93\xb9\x7d\x3d\x69\xf5\x29\x59\x55\xeb\xb1\x37\x53\x8e\xb3\x96\x6e\xa2\x56\x8f\x06\x2c\x94\x8e\x4e\x5d\x36\x51\x63\x1f\xd6\x8d\x9d\xa4\xa9\xd3\x36\x0b\x07\xb3\x16\xee\x7d\x2b\x4d\x88\x86\x84\x16\x18\x8e\xd3\xfc\xe2\x84\x53\x05\xa3\x68\xa4\x01\x28\x06\x9e\x35\x9b\xac\xe9\xd3\x91\x96\xac\x1b\x8a\x8a\x18\x75\x1b\x3c\xc0\xe0\xb6\x8d\xe6\x1a\x74\x0a\xcd\x7a\x13\xdb\xbf\x94\x47\xd0\xe4\x87\x16\xb3\xa2\xa1\x36\x8d\xdd\xe3\x4a\xbc\xb0\xea\x91\xb4\x51\xb8\x4c\xd1\x62\x6a\x36\x8e\x0a\xd5\xd2\xb3\xb1\x94\xbb\x47\x2d\x83\x9b\x70\x2f\xc2\x2d\x5a\xc2\xd3\x9d\xba\xc6\x60\x38\x80\xca\x23\x24\xc3\x1a\x73\xd7\x47\x6a\xeb\x88\xee\x85\xb5\x6d\xc1\x5d\xb5\x6d\xbb\x6a\x5c\x32\xe0\x0d\x37\x70\x48\x60\x19\x6c\x8f\xa3\x55\x8f\x65\x48\x74\x89\x43\xc5\x36\x05\x69\xc5\x1a\x32\x9e\x36\xd0\x7a\xc2\xc5\x4f\x4b\x85\x95\x1a\x2e\x1b\x4d\x1e\x26\xf3\xff\x8d\x81\x65\x2c\xcc\xa0\x2a\x69\x3b\x8b\x68\x5c\x56\x65\x4b\xe6\xc6\x3b\x3c\xa3\x47\x02\xd1\xc0\x88\x0c\x18\xd3\x55\xd4\xc1\x49\x5f\x35\xe4\xb4\xa4\xe0\x0d\x46\xba\xb0\xa0\xd9\x5c\x69\xee\xba\xe3\x8e\x5d\xbb\x56\xe2\xdf\xa3\xbb\xe0\xae\x3b\xb4\x5d\x2b\xdd\xd9\xdc\x75\x47\x00\xc2\xa4\x21\x67\x60\x68\x08\xae\xfc\x48\x6d\xed\x4a\x38\xd3\x83\xbd\x53\xf9\x8d\x2c\x96\xda\xda\x5d\x2d\xbb\xbc\xc3\xdd\x81\xf7\x1a\x9d\x65\x69\xa4\xdd\xf5\x85\xc6\x0d\xbc\xc8\xe9\xa0\x6b\x18\xdc\x5e\x14\x3c\x7a\xa2\xbe\x17\x82\xb1\x74\x5c\x4c\xe8\x3a\x54\x4c\x32\x8a\xc7\xac\xac\xa4\x16\x96\x3c\xa8\xeb\x67\xb5\x8d\xd8\x27\x59\x37\xb9\xfc\x0f\x1a\x8a\x9c\x08\x31\x35\x99\x4d\xa7\x25\xa3\xb0\xc1\x4d\x85\x84\x03\x81\x62\xd0\x58\x0c\xb6\xc2\x8e\x8e\xba\xda\xba\x81\x2d\x5b\xba\xfb\xbb\x06\x82\x41\x58\xdb\x16\x28\x38\xa1\x69\x71\xd8\xe1\x58\xb7\xf9\xf6\x3e\xb1\xa3\x30\xc1\x65\x6a\x3f\x58\x98\x2a\x3b\xbc\x87\x62\x30\xa4\xc3\xda\x36\x58\xfb\x21\x47\xb4\x23\x68\x78\xee\xd8\xbe\xdd\x33\xe2\x11\x32\x76\xa3\x79\x9d\x47\xd4\xdc\xec\xda\x11\x39\x9b\x25\xce\x22\x7a\xd2\x97\x5a\xe1\xc0\x96\x6d\x1d\x02\x8b\x96\x81\xb4\xa3\xb5\x39\xd2\xab\x67\x46\x51\x82\xb7\xf4\xdd\x8e\x5c\x96\x41\x13\x35\x4f\xd3\x8a\xcb\x86\x81\x2a\xa5\x21\x2e\x0f\x35\x68\x59\x15\x2b\x6c\x25\xa9\xe9\x48\x67\xca\xa6\x29\x25\x51\x77\x40\xc3\x31\x9a\xa0\xa3\x96\xcb\xad\x1d\xc3\x01\x4e\xb4\xaa\xc1\x50\x02\xd6\xd4\x7e\xa8\x06\xd6\xd4\xb6\xd5\xc0\xa6\x1b\x8a\x32\x77\xed\x82\x75\x46\x1a\x86\x8c\x04\x71\x5b\xb1\x02\xc6\x32\x30\x24\x71\xfe\x83\x7c\x6a\x25\x14\x19\x6c\x85\xed\x5b\xbd\x8a\x97\xb9\x22\x89\x09\x54\x36\x2b\x56\xe0\xb5\x85\xa3\xe0\x62\x86\x89\x8b\xad\x2e\xa1\xa8\x96\x6c\xc0\xe5\x61\xbd\xbe\xf6\x23\xd3\x63\x11\xad\x94\xa2\xcd\x1c\x15\xf3\x72\xa6\xf8\x06\xbc\x23\x5c\x06\x3b\xb3\x78\x20\x89\x29\x46\x2c\x8b\x06\x83\xc2\xfc\x8b\xac\xbf\xad\x61\x19\x97\xef\xa0\x21\xe1\x15\x79\x4b\x5d\x3d\x1e\x64\x0d\x29\x43\x17\x8a\xf8\x55\x85\xa4\xc2\x9a\x84\x92\xcc\x1a\x78\x16\xe1\x2d\xad\x86\x4e\xcb\x24\x43\xcf\x92\x79\x14\x44\x53\x0c\xfc\x5a\x86\x68\x69\xbc\x68\x8d\x67\x0d\xaa\x84\x50\x95\x86\xb9\x32\xa1\x45\x8b\x16\x28\x5e\xe5\x41\x9d\xc9\xda\xcf\xa3\x6d\xb3\x85\x5f\x71\x0d\xe8\x6c\xcd\xb8\x29\x87\x6e\x53\xeb\x43\x21\xd3\x92\x0c\x2b\x94\x34\xf4\x6c\x06\xfb\x16\xa3\xbd\x7d\xdb\x06\xfa\x83\xd4\x59\xd6\xe2\x45\xc7\xe8\x4d\xfd\x6c\xfd\x6d\x66\x33\x19\xdd\xb0\xf0\x36\xc6\x4e\x45\x8b\x9b\x48\x39\x98\x29\x09\x8d\xe1\x3a\x5e\xee\x9b\xb0\x2e\x6c\xea\x41\xa4\x8d\x1a\x83\xd4\x49\x24\xa5\x3b\x5a\x0f\x87\x53\x4a\x2c\x85\x94\x0d\x9e\x00\x0c\x66\xb5\xb8\x4a\x46\xf1\xa4\x8c\xc7\xc2\x34\x1a\xb5\x58\x91\x5a\xc5\x5a\xa1\x73\x24\xbc\xec\xa7\x43\x00\x1a\x4e\x9a\x82\x50\xd5\xa5\x38\x5a\x95\xd0\xdd\x63\x2e\x0a\xba\xbe\x47\xf2\x25\x48\x5c\x91\x10\x24\x3a\x4e\xb6\x4a\x54\x3d\x23\x6b\x75\x41\xf2\x86\x71\x20\x25\x8f\xc2\xb8\x92\x48\xc8\x06\xd4\x35\x75\x14\x9a\xaa\x92\x4c\x59\xea\x28\xca\x4a\x54\x2b\xcc\xe3\xd0\x88\x2d\x99\x72\x7d\x61\x26\x86\xb7\x69\x62\x3b\xa5\x24\x59\x0b\x17\x53\x1f\xd3\xe3\x72\x71\x41\x15\x36\xf1\xbe\x10\x95\xa4\x4a\x96\x35\x93\x24\x5c\x3e\xf8\x2d\x67\x5f\x14\xca\x23\x19\xdd\x24\x2f\x04\x49\x8b\xd4\xb3\x96\x6c\xa4\xd1\xd2\x94\xe4\x8b\xac\xea\x14\x13\xa6\x65\x49\x33\x5d\xa5\x8e\xd6\x0c\x26\x0c\x85\x86\x53\xba\x2a\x87\x24\x23\x96\x52\x86\x48\x39\xa9\xb2\xbb\xf8\x60\x9c\xcc\x78\xf0\xcc\xb9\xb8\x2c\x71\x05\x46\xa5\xab\x68\x68\xb9\x2f\x59\x0a\x5a\x11\x16\x5e\x96\x3a\x1b\x56\x16\x25\x1a\xcf\x81\x34\xdd\x48\x4b\x6a\xa1\xc9\xe3\xe6\xb4\x05\xd7\x38\x49\x6b\x88\x34\x1e\x52\xbb\x9a\x6e\xc9\x74\x38\xeb\x97\x2d\xd8\xbf\xa5\xb7\xbd\xa7\x8b\x0d\xe2\x2c\x53\x68\x50\xc4\x2b\x14\x53\x27\x3b\x71\x8a\x46\xfa\x2f\xd9\x61\x30\xe4\x84\x6c\xc8\x64\x37\x18\x07\xd3\x63\x12\x9a\xec\x0d\x9a\xba\x9a\xb5\xe8\x9e\x18\x49\x20\x5d\x01\xe0\xa8\xe9\x18\x17\x22\x0d\xac\xd8\x31\x4d\x9d\xf6\xbd\xfe\x2d\x7e\x9d\xb3\xe0\xc5\xa3\x7b\x86\x68\x17\x99\x5d\x37\x45\x3d\xd0\xd4\x51\xe6\x5a\x6b\xeb\xda\x36\x39\x3b\xae\xb3\x22\x3c\xba\xae\xa6\x4f\xf7\x43\x7a\xb0\x3b\x3b\xac\xce\x59\xae\xc4\x9e\x2d\x9d\xdb\xba\xbb\xfc\x33\x57\x0c\xf0\xfb\xcd\xa3\x53\x39\x11\x8d\x8e\x26\x25\x70\xd3\x96\xad\x1d\x5d\x62\xe7\x16\xb1\xa3\xa7\xb3\x1e\xd6\x7e\x64\x06\x75\xc5\xed\xd6\x68\x64\xb9\x20\x8b\x77\x67\x51\xcb\xe2\x36\x6e\x74\xea\xc2\xb6\x0f\x89\x87\x42\x6f\x92\x54\x55\x1f\x36\xe9\x7a\x8a\x6e\xee\x50\x19\x64\xbd\xa8\x8e\xa2\xe9\xad\xaa\x23\x15\x83\x37\xf3\x25\x15\xad\x3b\x51\xbb\x0a\x2c\xc3\x6b\x75\x87\x60\x3c\x37\x75\xa4\x25\x1c\x70\x26\xad\xb8\x23\xb1\xb2\x7e\xe5\x1d\x2b\x57\xd6\xd7\xd6\x35\x06\x83\x2c\x3d\xa8\x75\x22\xc5\xa2\x98\x64\x3f\x80\x28\x09\x1d\xd2\x59\x3e\x94\xe0\x4a\xbc\x47\xa0\xc5\x74\x2d\x81\x63\xc7\xbb\x49\x30\xa5\x24\x53\x58\x99\xe1\xe1\xc6\xa3\x60\x86\x24\xc3\xb3\x58\x56\xd6\xae\xe4\xb6\x7d\xb0\x30\xef\xc2\x31\x64\x29\xde\x40\x76\x18\x1c\x9b\xf7\x74\xd1\x84\x56\x5a\x05\x41\x26\xac\x93\xc3\x38\x1d\xb5\xdd\x9d\x62\x77\xf4\xa6\xad\xed\x5b\x6f\x17\xfb\xda\x07\x84\x60\x3d\xf6\x8f\x6a\xda\x32\x94\x64\x52\xc6\xe3\x24\xde\x3f\x71\xec\x39\x05\xf8\x64\x73\xdb\x3d\xb5\xf5\xb5\xb5\xe8\x0f\x2d\x33\x41\x56\x33\xf8\xbd\x8c\x84\x56\x4c\x19\x09\x0d\x57\x78\x0c\x21\xe9\xe1\x26\xc5\x72\x2c\x85\x71\x0b\x47\x28\x8f\x48\x31\x8b\x54\xad\x62\x11\x5d\x17\x58\x86\x93\x11\x26\x59\xc7\x0a\x15\xbf\xaa\x48\x14\x5e\xf4\xe8\x09\x22\x65\x50\x26\x8b\x70\xe2\xbc\x12\xcd\x30\x52\xd2\x90\x82\x54\x14\xdd\x95\x47\xd3\x09\x8b\xec\x7e\xb2\xd6\x27\xdf\x9d\x45\xba\xca\x44\x91\xa5\xd1\xe4\x0f\x0d\xb2\xb8\xd0\xad\x14\xaa\xa5\x58\x4a\x5f\x69\x42\x09\x6f\xb3\xd2\x31\x0a\xb5\x03\x9c\x0f\x34\x36\xe2\x02\xad\x87\x71\xc9\x4c\xd5\xc3\x41\xc9\x4c\x05\xc3\x01\x9c\x0b\x11\xa7\xa9\x95\x25\x76\xe5\x72\xf3\x0e\x6d\x25\x5c\x49\x37\x62\x1c\x6d\x8f\x14\xdb\xca\x00\x57\x70\x3a\x3e\x10\x83\x16\xf0\xfc\x7b\x93\x61\x79\x25\x7b\x63\x45\x17\x14\x50\x4a\x4a\xa8\x1c\x78\x6f\xe4\x2d\x9c\xaa\x27\x93\x72\x9c\x8e\x78\xc8\x83\x92\x96\x11\x6e\x48\x8e\x77\x31\x61\x08\xfb\x0c\x3d\x9e\x8d\xe1\x3e\x02\xf1\xa6\x21\x9a\x78\x91\xfa\xa8\x4b\x48\xaa\x29\x07\xc9\x44\x98\x0b\x65\xc2\xb4\x64\xc5\x52\xb8\x45\x1a\x4a\x6c\xe7\x28\xcc\xe8\x8a\x66\xb5\xe0\xfd\x7b\x98\x92\x4c\xa8\xe9\x54\x46\x48\xbe\x3b\x2b\xa9\x8a\x35\x8a\x5f\xad\x14\x9a\x3a\xdd\x0d\x65\x6f\x18\x71\xbd\x92\x71\x1e\x35\x6e\xbc\x63\x81\x16\xfa\x8a\x05\x4d\x59\x4e\x9b\x50\x55\x76\xe2\xb7\x81\xc3\x78\xff\x08\x2f\xb3\x71\xda\x02\xcb\x60\x46\x37\x15\x4b\x19\x92\x4d\x34\xb2\xa2\xce\xa9\x6b\x32\xcb\x80\x21\xeb\x06\xd9\xe7\x50\x2c\xb3\xb0\xb3\x8a\xf5\x0c\x44\x56\x31\x96\x92\x63\x64\xe8\x40\x21\x5c\x4a\xaf\xb6\x0e\x69\x60\x5c\x3b\xf5\xcc\xd2\x16\x0c\x7a\x6c\xd2\xb0\xc7\x2b\x78\x5b\x31\x30\x92\x84\x3b\x08\xf7\x9e\xb2\xd0\xbf\x64\x88\x5f\x31\xb1\x63\x3a\x64\x73\x97\xe6\xa3\x30\x97\xc1\x1b\x17\xf5\xf4\x6c\x8e\x29\x8d\x16\xf6\x75\x70\x31\x43\x25\x01\x07\x75\xd4\xef\xb9\x4e\x8b\x55\x26\xaa\xe1\x69\x62\x51\x31\x14\x76\x4f\x62\xba\x86\xcf\x56\xdd\xc8\x77\x2c\xb6\xe9\x62\xa1\x7a\xc5\xdd\x99\x4c\x06\x63\x31\x3d\xab\x59\xe1\x00\x6d\x0f\x62\x2c\x25\x69\xa8\xb9\xa1\x82\xd4\x0d\x6e\x1b\xb8\x58\x80\xf5\xcc\xe2\xb3\x13\x19\x0c\xd6\xdf\x11\xf0\x2d\x56\xa7\x3c\x3f\x11\xc5\x48\x48\x41\xbb\x54\x91\x82\xda\xa5\x16\x22\x45\x80\xdb\xb4\x04\x33\x86\x8c\x8f\x7d\x99\x58\x83\xe2\x6c\x98\xe4\x2d\x22\x9a\x37\xa9\xa3\x44\x09\xa2\xc9\x19\x79\x4f\xc2\x5e\x55\xab\xa3\xa4\x85\x0e\xe3\xb9\x69\x8c\xbd\x56\x46\x85\x43\x46\x22\x23\xab\xf1\x1b\xb7\x90\xb4\x34\xb2\x25\xe5\x7a\xcd\x8a\x5a\x53\xed\x8d\x30\x14\xc2\x7b\x2f\x7c\x8a\x4c\xe2\xb6\x0b\xb9\xe1\x96\x1c\xc2\x13\x64\x7e\xe9\x13\x20\xfe\x1d\x55\xe0\x37\x70\x3b\x9b\xe7\xae\xfa\xda\x1b\xa7\x17\x92\x3c\x22\xc7\xb2\x78\xc8\x54\x55\xd4\xb7\x2c\xbc\x8d\x69\xc2\xac\x66\x29\x2a\xee\x5b\x09\x49\x51\x8b\x3b\x66\x71\x5d\x2c\xfa\x0a\x40\xd8\xd6\xd5\x1a\xd9\x88\x6a\x12\x35\x64\xfc\xd2\xaf\xb6\xae\x6f\x4b\xff\x00\x7e\xcb\xd9\x1f\xdc\x08\xe3\x3a\xa9\x67\x79\x48\x52\x61\x6d\x6d\x66\x23\xb1\x76\xb5\xd6\xd6\xde\x48\x7f\x2b\x09\xf8\xe7\xb0\xb6\xb6\x0b\x86\x34\x19\x46\xe0\x9d\x1b\xf1\xce\x38\x6b\x1e\x83\x86\x2c\xed\xa4\x3e\x13\x0a\xfe\x11\xd7\x35\x19\xff\x98\x29\x24\xb7\x12\xa7\xa1\xe5\x11\x05\x0d\x5e\x5d\x24\xbd\x4a\x71\x97\x27\xae\xa3\xd9\x5a\x0b\x51\xb1\x85\xea\x1a\x52\x24\xb2\xb7\x33\xa8\x0f\xc9\x85\x0d\x1f\xbc\xbf\x58\x8f\x62\xd6\xe4\x98\x6c\x9a\x92\x31\x8a\x8f\xdf\x91\xad\xee\x62\x6b\xa0\x6f\xbc\x93\xca\x10\x52\xa6\xe4\xed\x60\x71\x76\xc3\xcf\x7f\xf9\x76\xd8\x2f\xc7\x74\x2d\x5e\x50\x5d\x24\x1e\x5d\x0b\xdd\x23\x1b\x7a\x3d\x7d\x91\xaf\x58\x30\xae\x43\xc9\x4c\x37\x74\x34\x74\xac\x5a\xc5\xbf\xe7\x48\x67\xb5\x24\xed\xe9\x03\x29\xc5\x98\x95\xa0\x62\x6d\x21\xcd\x81\xb2\x44\x25\xa0\x65\x49\x0b\xd1\x5d\x16\xd9\xc1\xc7\x3d\x24\xae\xc3\xde\x2d\x03\x10\x77\x4a\xfc\xfe\x06\x2f\xf1\xc8\xee\x59\x3d\x39\x19\x82\x24\x53\x05\x63\xc2\x1b\x91\x07\xbc\xba\xc7\x9b\x8e\x92\x8a\xe6\x30\xa3\xf4\x35\x8a\x31\x82\x95\x53\x86\x0c\x07\x37\x7a\xed\xd1\x92\xca\x09\xd4\xd6\x29\x09\xa6\x6e\x5c\x7a\x28\x58\x5f\x5b\xe7\xec\x16\xc1\x60\x3d\x6a\x9b\x6c\xf8\x2d\x8c\xd0\xf5\x48\xb9\xd4\xd6\xe1\x09\x7a\xb0\xa8\x3a\x90\xdf\xf4\xce\xb8\x62\xc0\x50\x06\xd6\xd0\x50\x28\x71\x48\xcd\xd4\x20\x84\xbc\x87\x62\x7b\x84\xc1\x9a\x00\x12\x84\x13\x94\x40\xab\x73\xa2\xb6\x13\xaa\x1e\xdb\x59\x5f\x5b\x37\xac\x1b\x71\xd8\xc8\x94\x13\x8a\x80\x1c\x81\x6c\xe3\xd4\x15\x01\xf0\xac\xa1\x06\xc9\x2a\xae\x19\xb0\x73\x0b\xdc\xa4\x68\x8a\x99\x92\xe3\x35\x9e\x61\x83\xde\xd9\x73\xce\x37\xd0\xb4\xad\x7e\x46\x15\x4a\x5e\x22\x72\x3a\x14\x3a\x36\x46\x71\x1c\x38\x97\x4d\x41\xac\x4d\xe8\x6e\x39\x4e\x02\x75\x41\xf5\x58\x5b\xb7\x1a\x8f\x7a\x5c\xc7\xa7\x59\x66\x65\xc9\x6b\x0d\x92\xfe\x60\xb1\xfb\x75\xca\x31\x95\x4d\x7e\x6a\x24\x55\xad\x61\xfd\x85\xec\x36\x9b\x3a\x9d\x1e\x92\x77\x50\x09\x29\xab\x5a\xf5\x81\x65\xe4\xf4\x4e\xf1\x7c\x12\x77\x10\xa9\xf0\xb2\x6a\x54\xb6\xc2\x81\x70\x9f\xb0\xa5\xf7\xf6\x16\xa4\xe1\x02\x92\xaa\xb6\x04\xe8\x24\x13\x9f\x35\x32\x71\xf3\xc5\x9d\x16\x4f\xaf\x43\xec\xb4\x02\x9b\xec\xc7\xb1\x5f\x7a\x0c\x69\x90\xbc\xf9\x42\x93\x4c\xb6\xa3\x80\xd5\x47\xf1\x65\x00\xdd\x38\x44\x12\xc3\xb0\xab\x78\x28\xc9\x92\x55\xb5\x30\xc9\x26\xc3\x93\x45\xa7\x4e\xf8\x3c\x13\x79\x05\x13\x58\xc6\x26\x04\xe9\x62\x1f\x4d\xea\x30\x21\x99\x96\x6c\x84\x03\xcb\xc3\xf1\x16\xb8\x11\x65\x60\x9b\x29\x3b\x94\x3d\x7e\x13\x85\x47\x26\x89\xd3\x36\x46\x56\x0b\x43\xa6\x9b\x06\x91\xda\xc9\x66\x54\xfa\x56\xa4\xa0\xf7\x8a\x25\xc4\x0b\x0c\xf0\x96\x96\x40\x80\xae\x29\x51\x8b\x21\xf2\x91\xb6\xca\x26\x12\xca\x08\x19\x0b\xeb\x61\x26\x6b\x91\x4d\x20\x7c\x66\xc7\xca\x64\x2d\x72\x4a\x87\x1e\x2d\x09\x07\xa8\x59\x5c\x9f\x36\x2c\x0f\xeb\x2d\xc5\x93\x4f\x0d\xcb\xc3\x31\x67\x1a\x16\xb4\x15\x1b\x50\x2c\x1d\xaf\x8f\xc5\xea\x1b\x83\xb3\x92\x73\x26\x41\x23\x23\xb3\x95\x94\xc9\x5c\x30\x51\x23\x23\x17\x4a\x54\xff\x05\x2a\x27\x73\x36\x72\xf0\xbc\x7f\x94\xbc\xe0\xc4\x7a\xce\xd0\xd3\xdc\xa9\x1e\x72\xea\xad\x1e\x5a\xba\x3e\x43\x1d\x4f\x87\xcf\xaf\xae\x3d\xe4\x9d\x67\x9d\x7b\x48\x3c\xdf\xba\xf7\x10\x79\xbe\x6d\x60\x3a\x7c\x7e\x6d\x61\x3a\x3c\xbb\x36\x31\xa3\xc0\x0b\x52\xbb\x17\xa8\x4a\x2f\x54\x3d\x5e\xa8\xca\xbb\x20\x35\x36\xeb\x6a\x0a\x28\x09\xf9\x6e\x58\xc7\x2d\x78\x75\x72\xb6\x31\x63\xc8\x09\x65\xa4\xbe\xb6\xae\x77\x8b\xd8\xbd\xa5\xbd\x93\xad\xc7\x1c\x33\x9a\xda\xba\xbb\x74\x45\x83\x1f\x21\x13\xac\x84\x32\xe2\xbd\x6c\x2b\x78\xa3\x97\x3a\xc8\x00\x11\x4e\xef\x44\x53\x8a\x7a\x34\xde\xb3\x9d\x33\xb7\x07\x76\x9c\xb4\x38\xfd\x31\xe4\xa4\xac\x89\x6c\xcc\x85\xad\xb0\xbd\x63\x20\xba\xa5\x17\x6e\x95\xb9\xf7\x0c\x74\xb7\x74\x9a\xdf\x58\xbc\xa8\xdd\x36\xc2\x86\xac\x69\x34\xe0\xdd\xd2\x06\x4d\x8f\xcb\xa1\xe4\x68\xa6\x81\xfe\x2f\xa6\x25\x45\x0b\x67\x46\x61\x28\x81\x47\xe4\x50\x88\xbc\x0d\x0c\xf1\x07\xe3\x6a\x42\x21\x4b\xcf\xa8\xf2\x90\xac\x86\xe2\x8a\xd1\x1a\xae\x81\xa1\x68\x43\x4a\x4f\xcb\x0d\xe4\x78\x78\x43\x52\x6f\x30\x8d\x58\x43\x52\xb1\x52\xd9\x41\x7c\xc8\xaa\xe0\x10\x53\xf5\x6c\xbc\xc1\xff\xbc\x3d\x39\x2b\x1d\x4e\x8e\x66\x14\x24\xd5\x23\xa9\x52\x3c\xae\x6b\x0e\x0f\xb4\x14\xb1\x97\xbb\xcc\x06\x34\x0d\x66\x3e\x6a\x42\xa1\xb8\x9c\xb1\x52\x28\x91\x35\xa1\xcd\x64\xfc\x15\x69\xaa\x6b\x42\x6c\x5a\xa3\x1b\xf4\xa5\x73\x2b\x4e\x05\x72\xea\xa4\x3b\xfd\xad\xce\xb7\x19\xd8\x69\x48\x31\x95\x41\x45\x55\xac\xd1\x56\x3a\xf1\xc2\x30\xce\x95\xa1\xeb\x24\x02\x8f\xa4\x15\x7d\xa1\xb2\x2e\x78\x72\x66\xb0\xe8\x49\x55\x06\x45\x54\x83\x5e\xa2\x1a\x3e\x54\x47\xaf\x15\x49\x46\x2c\x15\xc4\x68\x58\x55\x06\x71\x68\x52\xb2\x5c\x52\xce\xb7\x72\x8a\x69\x92\xd1\x4a\x4a\x6e\x1d\x5a\x5f\x03\xb9\x0b\x92\x01\x76\x64\x95\x1f\x46\xc3\x61\xdf\xff\xce\x50\x6b\x45\x19\xd3\x1b\x05\xe7\x56\x8c\x7f\xf6\xb1\xfa\x36\xa6\xc0\x02\xa7\xa2\x70\x76\x22\x3c\xda\x93\x49\xb8\x62\xe2\xc5\xb0\x16\x93\x2c\x59\x23\xa7\x4d\xe9\x09\x05\x7e\x92\x6e\x92\xe9\x00\x3b\x6e\xcf\x66\xcd\x68\xc1\x97\x1d\x0c\x15\xe6\xce\x74\x47\x97\xbd\x91\x64\xc7\xec\xd0\xc4\x5f\x49\x8c\x86\x0b\x13\xf3\xf6\x78\x1c\xcd\xb0\x8b\x6b\xda\x90\x65\x48\x31\xfc\x4a\x9e\x9c\x5a\x42\xda\x86\x1d\x10\x0e\xb2\x55\x81\xd7\x19\xe5\xc0\x32\x36\x11\xa6\x33\x76\x7c\x18\x1f\x6e\xd1\xd4\xd1\xc2\x89\x28\xb2\xc9\x56\xd8\x3f\x0f\xc7\x61\x5d\x31\xe6\x20\x3e\x36\xd8\x12\x88\x8b\x64\xcb\x0c\xaf\x95\x86\x15\x35\x1e\x93\x8c\x38\xa7\x49\x13\xf5\x5c\x8a\xea\x1d\xc7\xf0\x12\xc1\x70\x3c\x18\x0c\x28\x09\x8d\x28\x61\x2a\xca\xa9\x13\x8b\x30\xd5\x86\xbf\xc7\xfb\x1f\xfe\xfa\x88\x1e\xbf\xbf\x00\xf7\xc0\xce\xfa\xfe\x57\x53\xa4\x69\xdd\xdc\xfd\xaf\xf7\xe5\x39\x73\xfd\xe3\x5b\x05\xe7\xd5\x0a\xce\xbe\xfe\x1b\x57\x47\xe6\xee\xff\xbf\x2f\xcf\x6c\xeb\xff\x7c\xb4\xc1\xd9\xd7\x7f\x53\x73\xf3\x5c\xfd\xbf\x2f\xcf\xd9\xd6\x3f\x9d\xaf\xe3\x49\x57\x7c\x96\x71\x9c\x81\xff\x21\xb2\x3a\xb2\xce\x55\xff\x6b\x9b\x56\x47\xe6\xee\x7f\xbe\x1f\x0f\x5a\x35\x79\xd4\x2d\x9a\xdb\xd0\x93\x8d\xcc\x55\x1f\xbc\x8b\x2e\xd3\x78\x8f\x35\x45\x0f\x0e\xd4\xef\x18\xa4\xa7\x67\xee\x5c\xe4\x39\xc5\x16\x9c\xe3\x08\x38\xd7\xe7\x6c\xfb\x3f\x57\x2f\xb3\x8e\xe3\xec\xf5\xff\x9a\x75\x4d\x73\xf3\xbf\xf7\xe5\x39\x8f\xfa\x9f\x35\x5b\xd4\xd9\xd7\x7f\x73\xe3\xba\xe6\xb9\xfa\x7f\x3f\x9e\xf3\xaf\x7f\xaa\x88\x75\xff\xe9\xc0\x19\xc6\xff\xa6\xc6\x26\x57\xfd\xaf\x8e\x44\xd6\x34\xcf\x8d\xff\xef\xc7\xc3\x8f\xff\xfe\x75\x8b\xa6\x03\xc9\x55\xab\xe0\xca\x50\x67\xef\x96\xce\x2e\x71\xf3\xed\x7d\xf4\xcc\xaa\xd8\xdb\xde\xd3\xd5\x4a\xfc\xad\x44\xee\xdb\xfa\xa3\xbd\x9b\xc5\x6d\xb7\x8a\xfd\x42\xfb\xd6\xae\xce\xd6\x46\x0e\xbd\x75\xbd\x13\xbd\x75\xbd\xd8\xd9\xd5\xb7\xb5\xab\xa3\x7d\x20\xba\xa5\x57\xbc\xad\x7d\x6b\x6f\xb4\x77\x73\x3f\x75\x15\xbb\xdb\xb7\x6e\xee\xda\x14\xed\xee\x12\xfb\xb7\x6c\xdb\xda\xd1\x45\x50\x0c\x6c\xd9\xb4\xa9\xbf\x6b\x40\xbc\x29\x3a\xd0\xdf\xda\xbc\x06\x3b\xe0\xf7\xcc\x28\x16\x9c\xc2\xae\xed\x03\x5d\xbd\xfd\xd1\x2d\xbd\x2b\x7d\xf6\x4a\x79\xab\x8f\x17\xd3\x88\xf9\xb8\xe0\x2e\x91\x1d\x62\xf0\x4c\xbe\x86\xd6\x17\x7c\xc1\x50\xa2\x2f\xda\x01\x43\x19\x2b\x65\xc8\x52\x1c\x86\x6e\x93\x54\x15\x86\x6e\x23\x97\xb6\x42\xb7\x69\x7a\x28\xab\x65\x4d\x39\x1e\xca\x48\x86\x94\x96\x2d\xd9\x80\xa1\x74\xf3\x1a\x18\xda\xb2\x1a\x86\x12\x9a\x1e\xd2\xd3\x8a\x15\x4a\x20\xb7\x10\x3e\x71\x87\x3c\x20\xdc\xb0\x2c\x85\xfc\x22\x94\x5a\xf8\xd8\x57\xc8\xb4\xe2\xad\x49\x2d\xbb\x6a\x55\x64\xa4\x78\xb5\x33\x7c\x0e\x1d\x1b\xdf\xc3\x82\xf4\x52\xce\x2c\x5a\x4b\x38\xdc\x50\x60\x73\x0a\x9c\xd9\x7f\x8b\x23\x00\xbc\x23\x00\x7d\x6a\x83\xec\xf5\xa6\x3c\x9d\x5d\x85\xdd\x30\xb4\x3e\x9c\xf2\x13\x35\xdd\x6f\x68\x88\x70\x98\x9d\x4d\x18\xba\x37\xeb\x1b\x82\x25\x58\x2c\xc8\x0e\xf0\xf9\x6c\x09\xcc\x9c\x4b\x6f\x77\xaf\x6c\xce\xd6\x67\x31\x93\xb3\x0d\xc1\xb2\x38\x73\x5a\x45\x4e\xee\x1f\x5a\x9f\xcd\x3d\x67\xf7\x9c\xf7\xf8\x3f\x8b\xad\x80\x33\xae\xff\x1b\x57\xbb\xe6\x7f\xeb\x9a\x9a\xd7\xcc\x8d\xff\xef\xc7\x33\xe3\xf8\x5f\xd8\x0a\x40\x63\x3f\xbb\x66\x52\x1c\xbe\x8c\xf8\xa8\x26\xa5\x95\x18\x19\xa4\x1c\x57\x4b\xf8\xf0\x33\x8d\x19\xd4\xc7\xb4\xcb\x27\xb3\x18\x64\xdc\x17\x50\xe6\x54\xcf\xb9\x3c\x67\xee\xff\x5c\x45\x9d\x63\x1c\x33\xaf\xff\xd6\xae\x6d\x5c\x33\x6d\xff\x77\x4d\xd3\x1c\xff\xeb\xfb\xf2\x7c\xbc\xab\x7b\x53\x69\x49\x49\xc1\x5e\x06\x6e\x00\xc8\x76\xf4\x72\x62\x6f\xa3\xf8\x8e\xfe\x62\x98\x36\xb0\x1e\xcc\x07\x6d\xe0\x1a\xb0\x14\xfb\xad\x98\x41\x7e\x77\x93\xd3\x04\x80\x14\x0d\x0a\x37\x0f\x00\xf0\x58\x2b\x41\x1f\x6b\x85\x0e\xf3\x54\x19\xf5\x5e\xee\x0c\x57\x4a\xc3\x1d\xa0\xe1\x0e\x50\xff\xcc\x3c\x4e\xb3\xc2\xcc\x4a\x4e\x0c\xfa\xff\x08\xc5\xdd\x66\x2d\x70\x9a\x2c\xda\xbe\x97\xad\x38\xfa\x7d\x94\x36\x46\xb7\xd9\x09\x9c\x26\x0b\xf7\x67\x2f\x5b\xf1\x79\x33\x94\x8b\xfb\xa9\xa6\xe6\x56\x1a\x9f\x5f\xb9\x6c\x2f\x05\x0e\xb3\x84\x8b\xf7\x72\x5c\x7f\x00\x6c\xee\xdd\x06\x6e\xbe\xb9\xb3\x6d\xfb\xb3\x8f\x2d\x3e\xdc\x19\x7e\xe5\x4b\x99\xbf\x12\x57\x8f\x95\xe2\x9d\x1a\xe4\x7e\x3d\x5f\xfe\x2f\xc0\xb2\x92\x0a\x69\x21\xc2\xc2\x00\x80\x46\x00\xc0\x92\x9f\xbd\x51\xf1\xd4\x4b\x9f\xde\xb5\xe3\xb2\x1b\xf6\x75\x74\xfd\xc7\xb1\xce\x9e\xbf\x3d\xf5\xdc\xdd\xdb\xaf\xf9\xea\xc4\x89\x45\xaf\xfe\xfb\xeb\x8b\x94\xa9\x47\x5e\xf4\xcb\xc7\xe1\x72\x00\x2e\xf5\xc0\xbf\x06\x00\xb8\xc4\x03\x7f\xd8\x07\x0f\x95\x79\xcb\x19\x03\xde\xf8\xed\xa5\xde\xf8\xc1\x12\x6f\xf9\x25\x85\x16\xe5\x7c\x6e\xf1\x91\x93\xf6\x49\xcf\x2f\x7c\xfc\x67\x7d\xd2\x79\x8d\x4f\xbc\x1d\x3e\xe9\xfc\xaa\x4f\x79\xae\xf7\x91\xf3\x19\x1f\xff\x5b\x01\x00\x35\x1e\xf8\xb8\x4f\xfa\x3f\xef\x23\xe7\x8b\x3e\xf8\xe3\x25\xde\xf8\x69\x1f\x7c\x4f\x59\xb1\xcd\xf3\xcf\xbf\xfb\x94\xf3\x94\x4f\x79\x7e\xcf\x27\xfd\x86\x8f\x9c\x11\x1f\xff\x7d\x3e\xfe\xf7\xf8\xd4\xcb\xd6\x52\x6f\xfc\x80\x4f\xf9\x1c\xf4\xc1\xbf\xed\x13\xef\x2e\x9f\xfc\x26\x7d\xd2\xbf\xc2\x47\xce\x7f\xf3\x89\xf7\xbf\xfb\xe0\xbb\x7d\xe2\xd5\xb0\xff\x8b\xc0\x0b\xd7\x11\xfb\xa5\x74\xba\xb5\xa0\x02\xd5\xe3\x95\xe0\xb1\x76\x67\x6b\xfc\x69\x19\x6a\x6f\x17\x81\x1d\xab\x88\x7d\x82\xea\xab\x93\xe5\xc8\xff\x62\xf0\xa8\xcb\xbf\x04\x88\xfc\xa3\x57\x10\x7b\x05\x95\x7f\xba\x9c\xc8\x77\xfb\xaf\xc6\xfe\x17\x80\x8c\x2b\xb1\x8b\x31\x7e\x31\x38\x1e\x72\xe2\x0f\xd3\xf4\x60\xe5\x07\x00\xb8\x95\x29\x4e\x51\x4c\xa6\x75\x4d\xc4\x93\x4f\x51\x04\xa2\xa2\x29\x16\x10\x13\x8a\xa6\x00\x31\x3a\xd0\x23\xc6\x65\x43\x4e\x2a\xa6\x25\x1b\x03\x3d\x1d\xaa\xae\xc9\x03\xd2\xa0\x2a\x13\x37\x6f\x17\x31\x36\x22\x21\x01\x92\xaa\xdc\x23\x03\xf1\xc3\x8d\x6b\xf0\xfd\x5c\xd1\x90\xcd\x8c\xae\x99\xb2\x02\x92\x69\x4b\x49\xcb\xc0\xb4\x8c\x04\xfe\x21\x7e\xb8\xb7\xdf\x5a\xaf\xe8\xa6\x38\x28\x99\x72\x47\x53\xd7\x10\x10\x3f\x3c\x70\x6b\xbf\xb5\x61\x50\x32\x95\x98\xa8\xe8\x66\x34\xd6\x6f\x35\x36\xc6\x52\x92\x21\x5a\x86\xa4\x58\x66\x34\xd6\xd5\x85\x7c\x0d\xf4\xf6\x5b\xeb\x50\x9c\x23\x8d\x8d\x8d\xd4\xbf\x4e\x4e\x6b\x9a\x96\x21\x4b\x69\xaf\x90\xfd\x12\x0a\xde\x45\x22\x3e\x43\x24\x6b\x50\x89\x74\xf5\xf5\x5b\x8d\x6b\x89\x47\x22\x76\x30\x9b\x88\xc6\xfa\x1b\x45\x9c\x88\x5b\xcf\x2f\x11\x28\xab\x5e\xd2\x3d\x73\xdc\xdb\x6f\x35\x63\x52\x01\xb9\xa3\x91\x16\x14\x1f\x7b\x51\x8c\xa2\x25\x7d\xc4\x14\x63\xee\xb7\x1a\x9b\x45\x9c\x54\x59\x4a\x8b\x8a\x66\xca\x86\xe5\x19\xf1\xd6\x7e\xab\x71\x75\x31\x63\x28\x4b\x03\xe2\x40\x44\xec\xea\x6f\x16\xfb\x6e\xe9\x5f\x2d\xaa\x40\xfc\x70\x5c\xed\x1b\x02\x84\x5c\x06\x88\x62\x82\xdc\xfd\x15\x63\xa9\x9d\x8e\x64\x77\x92\x64\xf3\x55\xde\xd9\xc4\xa0\x42\x3e\x9a\xf8\x7c\xf8\x67\x62\x83\xd8\x23\x4a\xa6\xa9\x24\xb5\xae\xad\xb7\xf4\xaf\x11\x81\x28\xca\x86\xa1\xe9\x22\x8a\xcb\x52\x74\xed\xdc\xc5\xc6\x0c\x59\xb2\xe4\xae\xad\xe9\x34\x48\xcb\xe9\x58\x66\x14\x97\x57\xd3\x1a\x51\xb4\x52\x86\x3e\x2c\xea\x59\x4b\xd4\x13\xa2\x21\x69\x49\x59\x4c\xa4\xad\xbe\x5b\x62\xf7\x90\x22\xdd\xc0\xbc\x60\x32\x4a\x94\x20\xdd\xe8\xbb\x25\x06\xe4\x11\xd4\xb7\x44\xcc\x44\x88\x8a\x45\x4c\x48\x8a\xca\x2f\x41\x0a\x3d\x8a\x16\xd8\x10\xa6\xdc\x89\xf6\xdd\x12\xeb\x97\xa2\xa8\xb1\x75\x35\x36\xc9\x69\x72\x69\x69\x50\x8a\xed\x8c\xde\x8c\xc1\xae\xa1\xce\xcc\x96\x01\x11\x88\x1f\xd6\x86\x71\x62\xd3\xfa\x90\xec\x14\x71\x0e\x65\x80\x8c\xfe\xb5\x28\xce\x75\x22\xea\xeb\x92\xaa\xea\x31\xd6\x46\x6e\xc6\x2e\x5d\x43\xbd\x1b\x44\x31\xa9\x65\xb1\xe4\x75\xa2\x48\xe8\x36\x44\xc5\x22\x67\x6b\xa3\x7d\xfd\x6b\xc5\xfe\x75\x62\x57\x17\x4b\x20\xee\x4d\xbd\x4d\x43\xeb\xd7\x76\xa3\xc6\x10\xed\xed\x17\x9b\x09\x5d\x2b\x6a\x90\x89\x98\x66\xa9\x40\xcf\xc8\x5a\x5c\x31\x00\x5a\x65\xc7\x15\xa3\x79\x0d\xd2\x14\x96\xae\x82\xa4\x6c\x99\x7a\x6c\x27\x5a\x61\x23\x48\x95\x35\x90\xc0\xba\x05\xa0\x45\xda\x10\x50\x95\x41\xd3\x8a\xc7\x56\xad\x0a\x9b\x7a\xb8\x19\x59\xe9\x5a\x1d\xd9\x23\xc8\x1e\x23\x2e\xa2\x1c\x97\x2c\x09\x88\xe2\xa0\x69\x12\xd5\x07\x44\x59\x8b\x03\x7e\xad\xb7\xb9\x3b\x7a\x53\x87\xd8\x14\x6e\x0a\xaf\x25\xbf\xb7\x6f\x17\x57\x87\xd7\x84\x37\x38\x6c\x4d\x11\xde\xea\x74\x6a\x2c\xc8\x68\x5c\x53\xf8\x59\xfc\x85\xfc\xe3\x19\x7d\x19\x28\x07\x15\xa0\x1c\xcc\xc3\xb3\xd3\x72\x8c\x94\xe2\xb9\x6a\x29\x76\x27\xf6\x52\x50\x0e\xe6\x63\x7b\x39\xa8\x04\x0b\x40\x39\xfe\x87\xf0\x4a\x6c\x96\x83\x00\xa8\xc4\xf6\x92\x69\xff\x00\xfe\xfb\xb3\x72\x32\x6e\xa0\x91\x24\x7b\xb5\xb2\x00\xad\x0e\xae\xa6\x8b\x97\x12\x50\x0e\xbe\x4d\xdd\xfb\x00\x00\xb9\x8f\x07\x4b\x01\x98\x0f\x56\x54\x10\x2c\x73\xea\x6f\x4a\xd1\x4a\x67\x0d\xb5\x5b\xc1\x4f\x57\xa2\xb4\x76\x50\xfb\xdd\xd8\xbd\x0c\x6c\xe1\xe4\x1d\x2b\x2f\xce\x71\x3e\xf3\xa9\x87\xe6\x01\x10\x00\x1f\xa1\xfe\x2f\x57\x94\x8b\x01\x58\x00\x14\x26\xef\x2a\x94\x9e\x79\xc0\xa4\x76\x92\xbe\xd2\x42\xfa\xd8\xfc\x9f\xad\x67\x0e\xd0\x71\xf2\x71\x17\xce\xd6\x6d\x4f\xba\xf0\xdd\xd4\x7f\x5b\xbb\x13\x67\xf6\x8c\x0b\x7f\x25\x4c\xcc\x07\x5c\xf8\x9b\x14\x7f\xfc\x46\x82\xcf\xa3\x6b\x32\xf6\x3c\xc9\xe1\x65\x1c\x7e\x80\xc3\xe7\x73\xf8\x11\x0e\xaf\xe4\xf0\xa3\x1c\x7e\x31\x87\x3f\xc7\xe1\x8b\x38\xfc\x05\x0e\xe7\xe7\x95\x79\x0e\xe7\xa7\x0a\xc7\x39\xfc\x3a\x0e\x3f\xc5\xe1\xfc\x8c\x63\xa4\xbd\xb8\x6e\xbd\x9e\xc3\x97\xb4\x11\x7c\x3e\xb7\x16\x43\x0f\xe4\xf0\x72\x0e\xaf\xe3\x70\x7e\xdd\x1c\xe1\x70\x7e\xdd\xb8\x9e\xc3\x17\x70\x78\x1b\x87\xf3\x3b\x5f\x02\x87\x2f\xe4\xf0\x3e\x0e\x0f\x73\xf8\x76\x0e\xbf\x88\xc3\x77\x70\x78\x15\x87\xa7\x38\x9c\x9f\xff\x66\x38\xfc\x32\x0e\x1f\xe1\xf0\xcb\x39\x7c\x37\x87\x5f\xc1\xe1\x0f\x70\x78\x13\x87\xef\xe3\xf0\xc5\x1c\xfe\x30\x87\x5f\xc9\xe1\x8f\x72\xf8\x12\x0e\x7f\x8c\xc3\xaf\xe2\xf0\xc7\x39\xfc\x6a\x0e\x7f\x92\xc3\x97\x72\xf8\x01\x0e\xbf\x86\xc3\x8f\x70\xf8\xb5\x1c\x7e\x94\xc3\x3f\xc0\xe1\xcf\x71\x78\x3d\x87\xbf\xc0\xe1\x1f\xe4\xf0\x3c\x87\xf3\xeb\xb9\xe3\x1c\xbe\x8c\xc3\x4f\x71\x78\x2d\x87\x83\xf6\x22\xbe\x9c\x83\x2b\x39\x7c\x05\x87\x57\x73\xf8\x4a\x0e\x5f\xc2\xe1\x75\x1c\x0e\x39\x3c\xc8\xe1\xc2\x9e\x63\x95\xc2\x78\x45\x4f\x08\x02\x61\xec\x80\x55\x6a\x1f\x15\xf6\x7c\xbd\xf2\xd9\x82\xbb\xbd\xd6\x08\x41\x60\x2f\xb7\x42\x10\x54\x5d\xd7\x06\xec\xe5\xc8\x9e\x42\x4e\x93\x2f\xd8\xb6\x6d\x2f\xbf\x0b\xd9\x51\x57\x9b\x3c\x8a\xed\x83\xc8\x8e\x54\xd0\xe4\x01\x6c\xff\x30\xb2\x23\xd5\x33\xf9\x38\xb6\x6f\x45\x76\xd4\x05\x27\x1f\xc5\xf6\x9b\x91\x1d\x75\xbd\xc9\x7d\xd8\x7e\x13\xb2\xa3\x2e\x37\xb9\x1b\xdb\x5b\x90\x1d\x25\x7b\x32\x83\xed\x4d\xc8\x8e\x54\xd3\xe4\x0e\x6c\xbf\x1e\xd9\x51\x57\x9c\xec\xc3\xf6\x1a\x64\x47\x5d\x70\xb2\x0d\xdb\xaf\x46\x76\xd4\xf5\x26\x23\xd8\x7e\x29\xb2\xa3\xae\x35\x09\xb1\x3d\x80\xec\x48\xa5\x4d\x56\x63\x7b\x29\xb2\x23\x55\x36\x09\xb0\xfd\xdd\x7a\x08\x52\xa8\xcb\x4d\x1e\x9f\x42\xf6\x37\x90\xbd\x1a\xe7\x1f\xdb\x5f\x45\xf6\x4b\x70\xfe\xb1\xfd\x17\xc8\x7e\x29\xce\x3f\xb6\xff\x04\xd9\x2f\xc3\xf9\xc7\xf6\xef\x23\xfb\xe5\x38\xff\xd8\xfe\x2d\x64\xbf\x02\xe7\x1f\xdb\x9f\x41\xf6\xc5\x38\xff\xd8\xfe\x14\xb2\x5f\x89\xf3\x8f\xed\xff\x82\xec\x4b\x70\xfe\xb1\xfd\x9f\x90\xfd\x2a\x9c\x7f\x6c\xff\x1c\xb2\x5f\x8d\xf3\x8f\xed\x7f\x87\xec\x4b\x71\xfe\xb1\xfd\xd3\xc8\x7e\x0d\xce\x3f\xb6\xff\x05\xb2\x5f\x8b\xf3\x8f\xed\x9f\x44\xf6\x0f\xe0\xfc\x63\xfb\x3d\xc8\x7e\x1d\xce\xff\x69\x64\x37\x90\x1d\xe2\xfc\x63\xfb\x5d\xc8\xfe\x41\x9c\x7f\x6c\xaf\x59\x05\x41\x62\x1f\x6b\x3f\xdb\x84\x89\xd6\x07\xea\x21\x10\x72\x2f\xdf\x39\xf9\xdd\x29\xdb\x4e\x84\xab\xae\xdb\x8b\x9d\xaa\xae\xeb\x04\x40\x98\x68\xfd\xc7\x7a\x88\xbc\x55\xfc\x0f\xe4\x6d\xc3\x29\x21\xf7\xb2\x75\x95\x30\x5e\xf1\xcc\xf5\xa4\x49\x5e\x7c\xa7\xfd\x42\x31\xd0\x9d\xcf\xa2\x56\x58\xb4\x0b\x13\xad\x0a\x0a\x37\xb1\x76\x10\x8b\x09\x4e\x09\xb9\x97\x85\x83\x53\x65\x42\xee\xb8\x70\x30\x7f\xa3\x50\x72\x48\xf8\xde\x94\xb5\x44\x18\xaf\xf8\x04\x15\x78\x11\x12\xe8\x27\x6f\x77\xeb\x55\xf5\x10\x80\x6c\x83\xb0\xa7\xf5\xb6\xeb\x21\xca\x40\xee\x65\xeb\x22\x61\xbc\xf5\x73\xab\x20\xc8\x5f\x6b\xdb\x76\x5e\xb0\x6d\xfb\x50\xc5\x89\x55\x10\x94\xa0\xe0\x68\x89\x0e\xde\x7c\x36\x81\xf3\xb3\x0d\x67\x34\x61\xdb\x76\xd1\xde\x7e\x5b\xfb\xad\xed\xdb\xda\x07\xda\x73\xef\xf5\x37\xda\xc2\xc4\x23\x07\xde\xb6\x6d\xe1\x13\xc7\x1e\x0e\x00\x10\x17\xc6\xcb\x97\xa3\x8e\x2a\xe4\xba\x8e\x34\x1e\xc8\x7f\x61\xca\xb6\x85\x89\xfd\x99\xb7\x6c\x5b\xc8\x8d\x21\x23\xbf\xe0\xb4\x6d\xbf\x59\xa5\x03\x61\x62\x62\x37\x82\x27\xae\xf8\xd1\x95\x00\x7c\x0d\x0d\xcf\x42\xee\xf9\xaa\xe0\x18\x42\xdf\xac\xd2\xdb\xaa\xab\x82\x63\xfb\xe8\x6f\x58\x15\x1c\x7b\x14\xf9\x1e\x6f\x8b\x08\xb9\xb1\xc7\x91\x20\x54\x9f\xc2\xd8\x81\xaa\xbd\x1b\x17\x00\xd0\x3d\xf1\xc5\xb6\x93\xb6\xdd\x33\x91\xcd\x74\xe7\xde\xce\x77\xbe\x87\xfc\x56\xac\x0d\x42\xd0\x3d\xde\xba\x22\x08\x41\xe3\xc9\x43\x63\xfb\xde\xb6\x6d\x20\x1c\x1e\x7b\x00\x99\x00\x80\x43\x63\x9f\xa2\xc8\xc3\x14\x11\xf6\x1c\xa8\x16\x0e\x8f\x3d\xca\xac\x87\xc7\x1e\x63\x3f\x73\x63\x8f\x9f\xb4\xed\xe8\xf8\xe6\x4a\xe1\xf0\xd8\xe3\x0c\x1d\x1f\xc9\x0b\xb9\xb1\x36\xe2\x52\xdd\x5d\xf2\xba\x90\x9b\x9f\xff\x21\x89\xfd\x47\x75\x10\x24\xaa\x5e\x3f\x10\x9d\xf8\x58\x9b\xb0\xe7\xc0\x12\xea\x51\x18\xaf\xd8\x5f\x07\x41\x55\x70\xac\x0f\xd9\xf6\x1c\x68\xab\x0a\x8e\xed\x38\x89\x4b\xe8\x71\xe2\xfe\x04\x71\xcf\x10\xf7\x6a\x21\x37\x26\x9c\xb4\xed\xfc\x0d\x44\x6e\xb2\x0e\x82\xe8\x44\xb6\xb2\x3b\xf7\xf6\xe1\xb1\x07\x4e\xda\x76\x35\x49\xea\xc3\x27\x59\xb6\x1e\x45\xbf\xb8\x90\xd1\x89\xae\x1d\x42\x6e\x6c\x1f\x12\xb2\x01\x09\x99\x58\xfb\xd1\xc5\x00\x3c\x85\x02\x76\xe7\x5e\xcf\xbf\xf5\x3b\x8c\x8d\x20\x6c\x1e\xc5\x5e\x44\x58\xee\x27\xe3\x01\x61\xcf\x33\xe5\x13\x9f\xb0\xa7\xa6\xa6\xde\xfe\xde\x07\x8f\x2c\xdf\xbd\x7b\xf7\x6e\x6b\x32\x77\xb0\x3b\xf7\xfa\xc1\xc9\xea\x7f\xdc\xbd\x1b\x80\xaa\xce\x83\xc2\xc4\xcd\xa5\xb9\x43\x42\x55\xe7\xf7\xda\xc0\x21\x21\xf7\x73\x61\xcf\x4f\xca\x84\xe0\x4f\xf2\x9f\x25\x92\xd7\x21\xc9\x25\x54\xf2\xfd\x04\xbb\x04\x61\x97\x51\xcc\x24\xd8\x7b\x08\x0b\x52\x4c\x24\xd8\xe9\x2b\x00\x78\xea\x4a\x8a\xdd\x82\xb1\xb1\x03\xa8\x2d\x1c\x1e\x3b\xf2\x16\xcb\xf1\x51\xf4\x4b\xc8\x8d\xed\x78\xab\x50\x64\xd8\xcf\x38\x29\x44\xd4\x4c\xfe\xba\x1c\xd5\xd7\x23\xb8\xa4\xc7\x27\x52\xc8\xd8\xf0\x5c\xd5\xfd\x27\x90\xe4\x89\x27\x00\xf6\xf6\xcd\xe8\xc4\x26\x14\x16\xd9\xb2\x0b\x84\xb1\x9f\x56\x8d\x7d\x6f\x1e\x00\x42\xf0\xdf\x84\x3d\xbf\xab\x12\x72\x8f\x8c\xbc\x65\xdb\x55\xf7\x7f\xbb\x02\x35\x95\xdf\x95\x54\x8d\xf5\x97\x03\x50\xf5\xe5\x92\x07\xc6\xaa\x71\x31\xa3\xc0\x8f\x54\x9e\xb4\xed\x43\xe5\x4b\x40\x74\xa2\xb7\x5a\x18\x7f\x10\x4b\x1e\x27\xc9\xd9\xf0\xad\xaa\xbd\x37\xcf\x23\xe9\xc0\xc9\x9b\xa8\xc6\xae\x4f\xa0\x30\xc2\xc4\x47\xab\x85\x0d\xa7\xaa\xf6\xe6\x91\xf8\xb1\x03\xc2\xf8\xfe\xa3\xb8\xe3\x3c\x88\xc3\xe6\x9e\x38\x82\x8d\x09\x04\x56\xed\x7d\xae\x02\x37\x4a\x2c\x3d\xb7\x1f\x8b\x39\x3c\x56\xc9\x9a\x00\x00\x38\x31\xe3\xfb\xb1\xfb\x86\xc3\x55\x63\xbf\x42\x3a\xed\x57\x58\x51\xb5\xa1\x0c\x43\x96\xe1\x5b\xab\x85\xdc\x83\xc8\x56\xb5\xf7\x65\x24\x33\x78\x44\xd8\x73\xaa\x4a\xc8\x3d\x2b\xe4\xc6\x48\x76\xef\x43\x25\xb7\xe7\x54\x49\xd5\xd8\xae\x32\x9a\xdd\x08\x8e\xf6\x91\x3a\x9c\xd5\xab\x59\x56\xa1\x2b\xab\x9d\x15\x7c\x56\x23\x24\xab\x75\x7c\x56\x9f\x2b\x9f\x45\x56\x8f\x94\xe3\xac\x42\x92\xd5\x08\xc9\x6a\xdd\xb4\xac\x42\x92\x55\xab\x22\x2f\x9f\xc2\xad\xe4\x05\x1c\xef\x83\x44\xd6\xd8\x76\xac\x6d\xb6\x57\x0b\xe3\x7c\x2b\x49\x61\x14\x67\x14\x75\x16\x14\xdf\x75\x6d\x40\x18\x7f\x02\x25\xa4\x33\xf7\xab\xfc\xe8\x3b\xb8\xfd\x44\x73\x87\xaa\xf6\xde\x5a\x82\x0b\xc2\xae\xda\x3b\x5e\x8a\x92\x74\x50\x18\xaf\xb8\x64\x39\xd2\x31\xb8\x29\x0a\x13\x57\x7c\xf6\x72\x00\xba\x72\x2f\x7e\xad\x04\x2b\x87\xf5\x8d\x07\xf2\xdf\x7c\x1b\x67\x1e\xb9\x47\x37\x7c\xa7\xea\xfe\x04\x6e\x86\xa4\x8c\x72\x8f\xa0\x24\xf6\x4e\x94\x47\x84\x92\x23\xd6\x82\x1e\xd4\x4c\xa5\x72\x00\xba\x83\x27\x84\x3d\xef\x54\x45\x73\xdf\x11\x72\x13\xa4\x0e\x5a\x50\x84\x7b\xde\x29\xa9\x1a\x7b\xb2\x14\x80\xf6\xaa\x2f\x83\x07\xc6\x8e\x63\x19\xcf\x75\xe7\x9e\xc8\xbf\x65\xdb\x37\x1d\x2a\x8f\x00\x61\xfc\x41\x92\x6b\x5a\x09\x3f\xa9\xda\xfb\x30\x0a\xb9\x91\x64\x74\x1c\xfb\x14\xc6\x27\x8e\xe3\x82\xed\x2a\x9d\x45\xe9\xaf\xc5\x59\x25\x85\x99\xdb\x7f\x9c\xf4\xbc\xfc\x5b\x85\xd2\x17\xc6\xf7\x63\xb7\x0d\xcf\x5b\x15\xf9\xd7\x88\x02\x7b\x6f\x19\x04\x34\xd3\xc2\xc4\x15\x68\xfe\xc0\x95\xc8\x57\x4e\x16\xab\x65\xec\xfb\x55\x63\x7f\x35\x65\xdb\xff\x9b\x38\x93\x9a\x19\xdf\x7f\x80\x55\x10\xae\xce\x87\x88\xd0\xfb\xb1\xd0\xb5\xbb\xb1\xb1\x7f\x1f\x55\x9e\xc2\x9e\x43\x4b\x8a\x9a\xf3\x16\xe4\x98\x7b\x22\x85\xc7\x8d\x7b\x49\xa6\x8b\x15\x8d\xf4\x60\xd5\x62\x82\x6e\x38\x5c\x15\xc4\xfa\xd8\xaa\xc8\xaf\xc5\x9d\xf7\x63\x6d\xc2\x78\x45\x0b\x12\xc0\xe9\xdc\x7f\x7a\x9b\x28\xfd\xe8\xf8\x6d\xd5\xd1\x89\x8f\x65\x0a\x4a\xbc\x2d\x2f\xe4\x3e\x53\x41\x15\xfa\xff\xad\x29\x84\x42\x09\xc9\x97\xbf\x6d\xdb\xb9\xe7\x84\xf1\x3b\x8f\xc4\x85\xd5\xd7\xe0\x11\xb1\x6a\xec\x69\x54\x90\x9f\xf8\x3a\x1a\x27\xff\xbc\xfd\x8e\xf6\x3b\xdb\x3f\xd2\x2e\xde\xf9\xac\x30\xbe\x7f\x07\x4e\x5a\xb6\x2f\x7f\xed\x49\xdb\x9e\xec\x38\x8d\xca\xe5\xa7\xa8\x51\x57\xed\xfd\xe2\xef\x6c\x7b\xf2\xd3\x25\x00\x90\xf1\x17\x2b\xb2\xbd\x48\x83\xe7\x8e\x4e\x2e\x2b\x41\x9d\x19\x8f\xd7\x79\xf3\xa4\x6d\xef\x59\x5f\x5e\x35\xf6\x2d\x5c\x8e\xb8\x70\x27\x9b\x4f\xb3\x71\x5b\x18\x7f\x42\x20\x25\x8b\x7a\x41\xe3\xf7\xbb\x73\x63\x7d\x68\x0c\xbd\x83\xf4\x10\xd4\xc4\xba\xc7\x31\x54\xa8\xe9\x07\x51\x4d\x77\xe7\xde\xe8\xce\x1d\x12\x72\x87\xf3\xff\x72\x12\x79\x79\x62\x84\x34\x2e\xe4\x65\x72\xef\x69\xd4\x59\x76\x93\xd1\xd1\xa3\xe9\xd0\xe6\x2d\xe4\x9e\x9b\xfc\xd6\x69\xda\xaf\xc6\xbe\x29\xe4\x9e\xab\xda\x1b\x3d\x6d\xdb\xaf\x7d\x2e\xb1\x4f\x18\x7f\x62\x3b\x49\x17\x2a\x83\xfc\xb5\x9c\xf4\xbf\x39\x6d\xdb\xfb\x1a\x7f\x3a\xf9\x2b\x92\x09\x36\x3f\xc2\x29\x40\x19\x78\x5b\xc8\x4d\xa0\x8a\xcc\x3f\xfc\xdb\x42\x1e\x90\x5e\x27\x79\x20\xba\xe2\x41\xa2\xa6\x7e\x22\xa0\x8e\x74\x38\xff\x1b\xec\xe5\x11\xe2\x13\x2b\xab\xc9\xf5\xbf\x43\x09\xf3\x94\xdb\xe6\x25\x97\xa8\xdb\x07\xab\x1d\x72\x1f\xe2\xe5\x62\x2f\x93\x6f\xbd\xe3\xd3\x9f\xe8\x00\x83\x23\x6f\xfe\xdd\xcc\x9e\xb0\xa4\x15\xef\x16\xca\xa7\x3b\x77\x32\xff\xee\x9b\x38\x12\x1c\xfe\xa4\xd3\xe9\xf9\x37\x8b\xf1\x1f\x7f\x07\x8f\x9d\x3f\x5e\x84\xe7\x94\xa1\x6a\x34\x7a\xbe\xd1\x78\x20\xbf\xf4\x4d\x9a\xdf\xd6\x83\x55\x00\xe4\x55\x9c\xc7\x8a\x1e\x88\xe6\x9c\x57\xf4\x56\x01\xf0\x55\x34\x11\xe3\x3a\x6b\xe0\x4d\xdb\x7e\x1a\x59\xf3\xf0\x4d\xdb\xce\x2f\xfd\xad\x6d\xef\x2b\xce\x2f\xb7\x3d\xa5\x68\x00\x7c\x0d\xad\x8f\xb0\x27\x6e\x7a\xd8\x2f\x7c\xe2\xd8\x48\x29\x00\x89\xdc\xb6\x6f\x34\x7e\xdf\x35\x31\x3c\xdc\x75\x30\xdc\x90\x96\x0e\x75\x1d\x06\xf9\xd4\x6f\x6d\x1b\xeb\xd4\xef\x96\x02\xd0\xf8\xfd\xc6\x03\x44\x1c\xfa\x93\xef\x66\x6e\x7b\x4a\x71\x46\x22\x8b\x00\xc8\xff\xea\x0d\xac\x91\x85\xdc\xd8\x6e\xd4\xac\xf6\xbe\x8d\x62\xa9\x7a\xfd\x80\x90\x3b\x8c\xa6\x66\xa7\x89\x66\xea\x9e\xd8\x8f\x56\x0c\x68\xe2\x78\xda\xb6\xf3\x97\xfe\xd6\xb6\xa3\xb9\xaf\x0b\x13\x63\x0f\x9f\xb6\xed\x9e\xb1\x5f\x0a\xb9\xb1\x07\x70\xf0\xd7\xb1\xe8\x09\xe4\x4b\x98\x18\x43\xab\x88\xee\xdc\xfe\x91\xd3\xb8\x32\x76\x60\x70\xe2\xc0\x69\x32\x7f\x3d\xcd\xb4\xc9\x44\xea\x34\x12\x77\x78\xf2\x59\x3c\xa0\xd2\xd1\xf1\x03\x44\x33\x0f\xd4\x5e\xf6\xc0\x83\x11\x2c\xe7\x5d\x21\x37\x56\x37\x85\xc6\xc8\x52\xd4\x07\x1f\x43\x02\x36\x3e\xf1\x38\x8e\x17\x62\xe5\x7b\x12\x0d\x5c\x53\xb6\x6d\x75\x0b\xb8\xd2\xe7\xa1\xca\x45\x61\xd1\xe8\xf8\x32\x1a\x6f\x30\x84\xc4\x09\x39\x34\xcc\x61\x79\xdd\x39\x64\xab\xec\xce\xe1\xb0\x68\x34\x9c\x62\x33\x21\xe4\x13\x08\x7b\x0e\x41\x21\x87\xe3\xeb\xde\x60\x5b\x95\xf9\xfe\x13\xa4\xde\xc7\xf7\xa3\x32\xcb\x97\xbf\x49\x07\xb5\xaf\x57\xed\xcd\x97\x00\xd0\x33\x61\xd5\x5e\xd6\x78\xf2\xa9\x00\x9e\x66\xbd\x9d\xff\x87\x13\xb8\xed\xa3\x02\x12\xc6\x9f\x40\x85\x91\x3b\x2c\xe4\x9e\x3d\x8c\x0b\x0f\xf5\xf8\xfc\xa7\x4f\xe0\x9a\xb1\xbe\xd8\x78\xe0\x6b\x68\x39\x9c\x7b\x3e\xff\xf7\x6f\x60\xa8\x3d\xf7\x8d\xaa\xbd\xab\x2a\x00\xc8\x3d\xf3\x35\xb4\x30\x6e\x3c\xb0\xfb\x95\xb7\x73\xcf\xe7\x77\xbf\x41\xeb\x72\x73\x05\xf6\x7e\xfc\xb8\x6d\xef\x3d\x50\x35\x96\xb2\xd1\x40\xfb\x2a\xf6\x9b\x7b\x7e\xf7\x77\x2a\xf3\xb7\x32\x9f\x8b\xe7\x71\x2d\x22\xf7\x7c\xbe\x95\x39\x5c\x36\x0f\xa7\x93\xe6\x3f\xff\x8d\xe3\xa8\x62\xd8\xa4\x05\xd5\x56\xd5\x7d\xca\x14\x4e\x7b\x0a\x67\x61\x3f\xaa\xba\xc6\xef\xe7\xd5\xe3\x38\x5f\xb4\x3e\x71\xd9\xe5\x1e\x24\x65\xfb\x93\xee\xdc\x49\xd4\x99\xbf\x79\x82\x0c\x9f\xa7\x89\x92\x98\xb2\xed\xc9\x75\x53\x4c\x8f\xe6\x17\x9e\xb0\xed\xf1\x4a\x61\xe2\x8a\x96\x8b\x51\x0f\xa9\xb8\xec\x5a\xc8\xf7\x96\x9f\xbd\xce\x7a\xcb\x1b\xaf\xdb\x76\x62\x5f\xfe\xa7\xc7\xf1\x08\xf8\xcb\x8b\x00\x18\xaf\x7c\xed\x3b\x48\x7f\x8f\x7d\x03\x27\xfe\x5d\xbc\xe0\xa4\xfa\x33\x51\xa5\xe3\x8a\xab\xba\x64\x53\xf5\xe4\x92\xa9\xa2\x5e\x1d\xdf\xbf\x83\x24\x04\x65\x20\xff\x6d\x9c\x7c\x9c\xe7\xc9\xda\x29\xdb\xde\x27\x8c\x57\x7c\xea\x1a\xd4\x77\x5b\x6b\x17\x02\xf0\x14\x5a\xd7\xd3\xd4\x54\xe6\x1f\x3f\xce\xd2\x92\x78\x9d\xa5\xbf\x7b\x1c\xb7\xec\xe8\x84\x98\xe9\x6a\xfc\xa5\x90\x7b\x3e\x7f\xf5\xeb\x58\x03\x2c\xbc\x06\x82\x43\x5d\xfb\x40\xe3\x49\xe1\x70\xd7\x03\xb8\x25\x75\x7d\x0a\x08\x87\xbb\x1e\x26\xeb\xa0\xae\x47\x8b\xcb\xa3\xae\xc7\x28\xf6\x38\x5b\x18\xe1\xa2\xae\x78\x7a\x29\x1a\x98\xfb\x2a\x85\xf1\xb6\x6a\x61\xfc\x9e\xbc\x90\x7b\x10\xf5\xc0\xee\x12\x1b\x2d\x8a\x0e\x92\x88\x9e\x59\xca\x16\x45\x1f\x6f\x13\x26\xfa\x96\xe0\x21\xbc\x20\xe2\xff\x20\x11\x24\x18\x5a\x1f\x4d\x91\xfe\x86\xd6\x47\x04\xc9\x4c\x91\x69\x1a\xaa\xf4\x08\x11\x78\xfb\x52\xb4\x1a\x1a\xae\x14\x72\xcf\x1f\x1e\x7b\x60\xaa\xb8\x1a\xa2\x3d\x42\x98\xb8\x76\x4b\x00\x2f\x8a\xa6\xb8\x45\xd1\x14\x2a\x84\xcd\x78\x51\x84\x64\xd5\xbe\x8e\xd5\x25\x0c\x00\xf0\x54\x05\xee\x03\x76\xfe\x85\xdf\xe0\x86\x80\xf5\xc2\x86\xb1\xc7\x70\x9f\xbd\x83\xcd\x9f\x73\xbf\xe8\xce\xd9\xd1\x3d\x5f\x2f\x11\x0e\xfe\xa2\x42\x28\x39\x4a\xf2\x1d\xc9\xff\x33\x17\x68\xfc\x41\xdc\xdf\x83\xcf\x08\x07\xdf\xad\x88\x6e\xf8\x81\xf9\xc1\x9e\xb1\x5f\x5a\x5f\x7f\x8a\x28\xca\x9f\xa3\x38\x76\x15\xbd\xbf\xf6\xcf\x55\xd7\x11\x35\x36\xf1\x08\x69\x7c\x4f\xa0\xee\xd7\x3d\x21\x1f\x14\x0e\x8f\x1d\x3d\xcd\x96\xa1\xcf\x77\x4f\x3c\x81\x5b\x4e\x10\xb7\xd1\xee\xdc\xff\xef\x61\xac\xdf\x7e\x43\x87\x8d\xf1\x07\x33\x44\x25\xe4\x0b\x41\xc6\x90\xff\x43\x63\xc7\x11\x80\x66\x5c\x7b\x5f\x2d\x45\x4d\xe1\x8b\x3b\xa6\xc8\xd0\x86\xd5\xcd\xab\x55\xf7\x1f\x2a\xc1\x6a\x13\x2d\xac\x7b\xc6\x7e\x1d\x9d\xd8\x8c\x07\x21\xa4\x9c\x16\x08\x63\xdf\xac\xda\x7b\x79\x39\x00\xd1\xe0\xb7\xa3\x7b\x4e\x57\x75\xe7\xbe\x88\xa2\xad\xba\xff\xf1\x52\x00\xa2\x7b\x4e\x97\x54\x8d\xfd\x1d\x5b\x1b\x54\x93\xc2\xad\xee\xce\x7d\xb1\x72\x0a\xcf\x4b\xeb\x40\x74\x62\x0b\x5e\x0b\x4d\x91\x01\xfe\x34\x5d\x20\xfc\x65\x19\x0a\x7d\xa8\x1a\xad\x80\x48\x62\xaa\x71\x62\x8e\x57\xed\x2d\x29\x63\x73\xd3\xe3\xb8\xb7\x3e\x88\x03\xe5\x9e\xc8\x13\xcd\x7c\x1c\xd7\xc7\x09\x32\x37\xc5\x62\x73\xfb\xab\x49\xc6\x2b\xa7\xb8\x95\xc1\x66\xbc\x08\x9a\x62\x2b\x83\x5b\x8e\xa1\x69\xcc\x7e\xa2\xd8\x48\x3a\x72\xcf\x77\xe7\xa6\x84\x1c\x56\x6d\xf9\x7f\x3b\x56\x40\x88\xb2\xc3\xb3\xab\x27\x10\x3a\x7e\xed\x8a\xab\x70\x57\xfb\xd1\x02\x00\x9e\xfa\x40\xb1\xab\x2d\xcc\x77\xfd\x1a\xd7\x17\xa9\x8f\x5f\xe5\x3f\xff\x9a\x6d\xe7\x5f\xc4\x61\x36\x62\x65\x71\x74\x3e\xf5\xdc\x78\x20\xff\xd5\xd7\x58\xb7\xfc\xee\x6b\x85\x7e\x9e\xff\xd7\x63\xb6\xdd\x39\x8e\x06\xe1\x9d\xf3\x01\xc8\xfd\x58\x18\xaf\x78\x72\x89\x43\xb5\x7c\xa2\x10\xee\x6f\xb8\x70\x1f\x3d\x66\xdb\xb9\x1f\x93\x90\xdf\xad\x04\xe0\xb5\x43\xa4\x9f\x4f\x90\x81\x01\xad\xef\x70\x2d\x22\x5b\xd5\xde\x7e\x54\x5c\xc1\x6f\x09\x7b\xde\xad\x8a\xe6\xfe\x43\xc8\x3d\x48\xea\xb0\x1d\x2f\x6b\xde\x2d\xa9\x1a\x3b\x86\xa6\x94\x5f\xbe\x84\x0d\x5f\x5f\xac\x73\xd5\x1f\x74\xd5\xdf\x4b\x25\xac\xfe\x26\x22\x44\xdf\xd6\xb1\xfa\x4b\x94\xcc\xa2\xfe\xf0\x82\x8a\x29\xe2\xfd\x11\xd7\x58\x56\xa8\x3f\x38\x45\x17\xb1\x1f\x42\x9a\x0f\xfd\xc9\x7f\xf2\xb5\x62\xc6\x17\x55\x02\x30\xf9\x2d\x84\xaa\x1c\xba\x61\x1e\x00\x93\x5f\xc2\x6d\x71\xb8\x0f\x0d\x6a\x4f\xbf\x6a\xdb\x93\x76\xb1\x6b\xa1\xd9\x1e\x4a\x0a\x1e\x11\xb7\xbe\x8a\x7b\xec\xc3\xd8\x19\x43\x85\x76\xf5\x20\x6e\x93\xb9\x57\xe9\x6c\xef\xb1\xd7\x50\x13\xfa\x22\xf1\x89\xbd\x4c\x5e\xea\x23\xf4\xf4\xa4\x87\x50\x6e\xd4\x29\x0a\xed\x77\x08\xc5\x0a\xfe\x63\x53\x3e\xc5\xc6\x75\xcc\xc9\x8b\xfd\xca\x96\xab\xf7\xc9\xc7\x8a\x1d\xba\x6a\x6f\xe2\x3d\xdb\x9e\x0c\xdb\x6c\x3e\xfe\xfd\xaa\xbd\x97\x23\x3f\x77\xdb\x68\xbc\xea\xce\x4d\xa1\x92\x7a\x70\xb2\x98\xb7\x7f\x7e\x0f\xa5\x13\xc3\xf7\x4e\x16\x53\x37\x80\x93\x7f\x6a\xf2\x59\xbc\xd4\x6e\x35\x2b\x00\xc8\x7f\xe0\x55\xe7\x86\x68\x9b\x63\xbf\xb0\x5f\xd8\x73\x6c\x89\x30\x2e\x56\x0a\x1b\xc5\x6a\x6b\xb9\x30\xf6\x53\x6b\x9e\x30\x3e\x4f\xc8\x95\x09\x7b\x9e\xad\x14\x72\x62\xa5\xb0\xe7\xeb\x4b\xb8\x95\x11\x9a\xaf\xe4\x7e\x22\xac\xba\x32\x9a\x7b\x2b\x9a\x7b\x4f\xc8\x1d\x15\x0e\x9e\x2a\xc3\x4b\xa0\xff\x83\x15\xfb\x45\x20\x7a\xf8\xf0\x29\x1b\xb5\x8d\x23\x55\xf7\x3d\x49\xf4\xb6\x90\xdb\x76\x24\xff\x03\x9c\xd2\x6d\x47\xa2\xb9\x43\xdd\x25\x6f\x58\xf3\xa3\xe3\x5d\x40\xc8\x95\xf6\x8c\xd7\xd7\x0a\xb9\x1f\x77\x07\x27\xa3\x1b\xfe\xd3\x5a\x2c\xe4\xbe\xd5\x9d\xfb\x0d\x9e\xf2\xf7\x1c\xc9\x57\xe3\x30\x3d\x47\xa2\xe3\x9d\xb5\x95\xd1\x89\xee\x45\x95\xd1\xdc\x81\x68\xf0\x39\x61\xc3\x73\xd6\x55\xdd\xb9\x67\x84\xdc\x77\xb1\xd6\xee\x3a\x92\x7f\x39\x8f\x6a\xab\xeb\x88\x90\x3b\xd8\x33\xf6\xeb\x9b\x27\xae\x29\xb1\x2a\xbb\x73\xaf\xe7\x7f\xfd\x2b\xdb\xee\xc9\xad\xa9\xed\x29\x99\x8a\xe6\xee\xa8\xad\xec\xc9\x59\xb5\xd5\xd3\x72\xd5\x09\x40\xfb\xd3\x78\xcb\xbf\x07\x57\x03\x5a\x9f\x7d\xcd\x26\xcf\x75\xc2\x86\x13\x55\xf7\xaf\xb5\x6d\xbb\xab\xd1\xee\x6a\x3c\x89\xf5\xee\x06\x94\xc5\x83\x2f\x94\xa3\x09\xe7\xd5\x36\x5f\xc6\x7c\xf9\x46\x73\x76\x7f\x34\x77\x32\x9a\xfb\xad\xb0\xe7\x58\x9d\x30\x7e\x23\xde\xab\x0c\x9e\x8a\x06\xa7\x84\x83\xa7\x2a\x70\xb9\xbd\x87\xfa\xdb\xc4\x87\x40\xf4\xf0\x37\x5e\xc0\xe5\x76\xaa\xea\xbe\x25\xa5\x78\x6b\x4d\xc8\x6d\x7b\x52\xc8\x65\x0f\xe4\x1f\xce\xa3\x72\xc8\x1e\x40\x93\xa9\xf1\x6d\x4f\xde\x3c\x51\x5e\xd7\x3d\xb1\x13\xa2\x89\x7b\xb4\xe4\xe7\xd6\x06\x32\xca\xb4\x44\x73\xf3\x84\x89\x9b\xd0\x0a\xbd\x6a\xef\x7e\xa4\x06\x72\x37\x08\xe3\xf7\x54\x47\x73\xf7\xa2\xc1\xbf\x52\xc8\x95\x0a\x87\x6f\xc2\xef\x5c\x0f\xdd\x54\x0d\xa2\xb9\x7b\x2b\xa3\xe3\x57\x0a\x1b\xde\xb0\x3e\x2e\x4c\xdc\x03\x85\xdc\x09\x1c\x3a\xf8\x4e\x34\xf7\xcd\xee\x89\xce\x40\x44\xc8\xfd\x24\x7a\x70\xb2\xe2\xb5\x26\xdc\x2e\x73\x2d\xc2\xf8\x7a\x21\x77\x4f\xb5\x30\x3e\x72\x4a\xc8\x21\x79\x6d\xc7\x85\xc3\x6d\xa7\xd8\xa6\xc3\x9e\x03\x50\xd8\xf3\x0c\x8c\x6e\x38\x60\xd5\x08\x63\xdf\xb7\x7e\x23\x4c\xdc\x53\x8d\x83\x8d\x1c\x17\x36\x9c\xca\xee\x7f\xb3\x4a\x07\x55\x97\xdc\x54\xfd\xda\xfe\xaa\xeb\x40\x77\xee\x88\x70\xf0\x85\x8a\xee\x09\xb5\xac\x2d\x3a\x7e\x5b\xa5\xb0\xe1\x8d\xaa\xbd\xf7\xe2\x46\xd4\x0b\xf1\xc4\x3b\xf8\x2d\x21\xf7\x23\x61\xc2\x6a\x8a\xa0\x4e\x79\xf0\xf5\x8a\xd7\x1a\x71\xfb\x0b\x08\xe3\x95\x42\xee\xe6\x6a\x61\x5c\x38\xe5\x4e\x43\xee\xe6\x4a\x96\x0e\x61\xc3\xf1\x42\x3a\x6e\xae\xc6\xc1\x84\xe3\xc2\x86\x83\xae\x74\x08\x13\x9b\x4b\x7a\xc6\x93\x95\x28\x31\xd1\x92\x6f\xf4\x8c\xaf\xeb\xd9\xf0\x8a\xb5\xa2\xb0\xbe\x8d\x8e\xdf\x80\x8b\x77\xc3\x61\xab\x33\xba\xe7\x10\xcc\x7f\xf6\x65\xdb\xee\xd9\xf0\x4a\xf6\x95\x9e\xf1\x95\x68\xf6\x50\x89\xc6\x97\xfb\x5e\x46\x35\xd4\x75\x24\x9a\xbb\xae\x27\xa7\x57\x46\x73\x9b\x51\x23\xab\x73\x34\x32\x2a\x6f\xcf\x21\x88\x82\xff\xcf\xd7\x9e\xc6\xed\x8c\x2c\xd2\x0e\xfe\xaa\x22\x9a\x3b\x3d\x39\xbf\x38\xcf\x7c\xb3\x4a\xbf\xa9\xba\x1d\xcf\x3e\x6f\x9c\xe2\xd7\xdb\x4f\xd2\x76\x39\x1f\xd5\xf3\xfd\xff\x81\xb7\x26\xec\xec\xfe\xf6\xfd\x90\x4c\x01\x8f\x20\x5f\x8d\x3f\x9d\xfc\x05\x19\x2a\xf1\xfb\x37\xee\x8d\xdb\x72\xa9\x1e\x2e\x8f\xc3\xe5\x83\x70\xf9\xed\x70\xb9\xd0\xb2\xbc\xa7\x65\x79\x3f\x5c\xfe\x61\x20\x0c\x0c\xf4\x35\x34\x86\x23\xb0\x29\x12\x81\x5b\x6e\x09\x80\x4e\xc9\x92\x5b\x20\xe8\xd0\x31\xf9\x73\xa8\x5b\xd6\x92\x56\xaa\x05\x36\xad\x0e\x80\x6d\xa6\xcc\x7d\x95\x55\x31\xf1\x57\x29\x46\x03\x80\x3f\x67\xd3\xd2\x82\x39\xdc\x0d\xb0\x5c\xcd\xc2\xc1\x51\x4b\xae\x33\x83\x50\x95\x13\x56\x00\x34\x64\x0c\x3d\xd6\x60\xca\x6a\xa2\x21\x11\x67\xa7\x5f\x30\xcd\xb8\x1c\x0f\x90\x33\x31\x75\xcb\xe3\xf5\x70\x93\xb8\xb9\x6b\x60\x53\x77\x10\x2e\x77\xc1\xfd\x5d\x03\x9b\x3a\xeb\x61\x84\xb8\x84\x12\x71\xb3\x15\xd4\x93\x33\x31\x32\x86\xf8\x7b\x77\xf4\x90\x0b\xcd\x3f\x3b\x83\xb7\xd2\x2c\x7e\x2e\x19\x7f\xca\x2f\xa1\x60\x9e\x30\x96\x5a\x93\xbe\x64\x67\xd9\x1f\x18\xcd\xc8\x2d\xf8\xcb\xaf\x0d\x19\x55\x52\xb4\x8d\x98\xa9\xda\x94\xad\xd6\xac\x95\x08\xad\x0f\xb0\x77\xf2\xce\x22\x10\x7b\xc4\x98\xae\x99\x96\x91\x8d\x59\x10\x73\x63\x68\x3a\xfe\xd0\xab\xc2\x2e\x50\x0d\x1b\xba\x25\xc3\xe5\xf7\x60\xfa\x7d\xbe\xa8\x2c\x1d\x6e\xea\x84\x28\xbf\xa4\xde\xcc\x16\x28\x8a\x19\xdd\x84\x75\x85\xef\x2e\x2d\xbf\x27\x1b\x84\x37\x60\x9e\xfa\xd0\x0d\xa6\x72\x8f\x5c\x17\x74\xb9\x02\x5a\x6c\xfd\x03\x9d\x5b\xb6\x0d\xe0\xab\xb4\xbd\x5b\xa6\x97\x20\xe0\xfc\x75\x6d\xdd\x7a\x66\x7f\xc5\x6a\xe8\xae\x87\xa6\x34\x24\xc7\x45\xf2\x41\xae\x15\xf0\xde\x2d\x62\xef\x96\xde\x9b\xba\xb7\x74\xdc\x52\x08\x74\xe6\x70\xbb\xa0\x77\x30\x5c\x79\xe4\xcb\x0e\xf8\x14\x18\xa6\x65\xc4\x5f\x20\x1a\x0d\x87\xc3\x01\x00\x4a\x96\x96\x6d\xec\xa4\xef\x9d\x0f\xbc\x68\xdb\x3b\x00\x00\xc7\x5f\xb2\x6d\xbc\x62\xfa\xa5\x6d\x1f\x45\xe6\xcb\xb6\x8d\x56\x4b\xd5\xaf\xdb\xf6\x40\x09\x00\x91\x77\x6c\xfb\x38\x00\x60\xdf\xbb\xb6\x5d\x4b\x0f\x71\xb0\xb3\x0a\x25\xf7\x6c\x05\x25\x23\xd5\x25\x4b\x2f\x9a\x5f\xb9\xaf\x84\xbc\x43\xbf\x06\x00\xb0\xfd\x45\xdb\x8e\xa0\x45\xef\xa2\xea\x4d\x8b\x96\xdc\x5c\xb5\x70\xb8\x72\x37\xb8\xf1\xea\x8d\xd7\xaf\xae\xad\x61\xe1\x51\x3a\x76\xbc\x64\xdb\xfc\xb9\x96\x7a\x00\x00\x5a\xa3\x6c\xff\xa5\x6d\xe3\x33\xae\xed\x8b\xaa\xef\x2b\xed\xb8\x78\x9e\xf0\x57\x65\xff\xad\x7c\xa2\xe2\x2f\xe7\x6d\xda\x33\xbf\xac\x6d\x7e\xe0\xa2\xf9\x95\xed\x0b\x49\x5c\x7f\x09\x00\xa8\x7b\xc9\xb6\xf1\x79\x0b\xec\xff\xe6\x8b\xe7\xb5\x5f\x34\x1f\xc9\x8d\x00\x00\xbe\x00\x00\x58\x7f\xca\xb6\xf1\x99\x56\x26\xaf\x87\xc9\xdb\x33\x7f\x27\x12\xd6\xb9\xb0\xf4\xfb\xc8\xdc\xb4\x10\xa7\xe1\x05\x00\x40\xfc\x1d\xdb\xc6\xe7\x72\xa7\xa5\xa1\x77\xcf\xfc\xb2\xd3\x25\xc8\x7b\x74\x21\x39\x07\x53\x5d\x02\xc0\x97\x7e\x6d\xdb\x97\x16\xd2\xbc\xf5\xe2\x79\x7d\xc5\x38\xc0\xdc\x33\xf7\xcc\x3d\x73\xcf\xdc\x33\xf7\xcc\x3d\x73\xcf\xdc\x33\xf7\xcc\x3d\x17\xec\x61\xe7\xfd\xd9\xf9\x7e\x76\x9e\x9f\x9d\x39\xff\x76\xb9\xd3\xfe\x33\x97\xfd\x18\xb5\xb3\x33\xf3\x8b\xe8\xe1\x73\x76\xe6\x9b\xdd\xa3\x63\x67\xed\xd9\xfd\x39\x76\x46\x9a\xdd\x3f\x60\x67\xa0\xd9\x19\x7b\x76\x56\x9a\xdd\x37\x60\x67\xa1\xd9\x9a\xe7\xad\x29\x5b\x07\xdc\x7d\xec\xc2\x99\x77\xba\x62\x60\x67\xdd\xd7\xd3\x4b\x04\x6c\xa1\xf7\x71\xea\x91\x9d\x61\x67\x67\xb8\x0b\x77\x0a\xda\x8a\xf7\xc1\xd1\x13\xa1\x0e\x97\x3b\xc5\x17\xce\x84\xb7\x55\x39\xf1\xc7\x2e\x76\xa6\xf3\x61\x9a\xbe\x05\xae\xf8\xa6\x6c\x92\xfe\x3c\x2d\x28\x9b\xda\x59\x3a\x8e\x53\xfb\x6e\xea\xfe\x0e\xb5\x9f\xcd\xdd\xef\x3f\xe4\xc3\xee\xcd\xbb\x9f\xcf\xd3\xf6\xf0\x65\x6a\x1e\xa2\xe6\x0f\xa9\xf9\x0a\x35\x4f\x52\x73\x1e\x3d\xf4\xbf\x98\x9a\x2b\xa8\xd9\x4c\xcd\x4d\xd4\xbc\x95\x9a\x09\x6a\x0e\x51\xf3\x3e\x6a\x3e\x44\xcd\xcf\x53\xf3\xcb\xd4\x3c\x44\xcd\x1f\x52\xf3\x15\x6a\x9e\xa4\xe6\x3c\x5a\xf1\x8b\xa9\xb9\x82\x9a\xcd\xd4\xdc\x44\xcd\x5b\xa9\x99\xa0\xe6\x10\x35\xef\xa3\xe6\x43\xd4\xfc\x3c\x35\xbf\x7c\xb9\x67\xf1\x14\x9e\xb6\xf6\x99\xa9\x1e\x36\xb8\xec\xaf\x84\xbd\xfd\xbd\xe9\x83\x6f\xee\xe8\x68\x81\x75\xdb\x06\xb3\x9a\x95\x85\xeb\xc2\xab\xc3\x91\x50\x63\x73\x16\x5b\x57\x07\x09\x30\x73\x02\x71\x5b\x2d\x29\xf0\x1c\x38\xf1\xd2\x42\xbf\x74\xe2\x65\x85\xfe\xe8\xc4\xcb\x9d\x97\x77\x0a\x78\x45\xa1\xfd\x3b\xf1\x79\x85\x7e\xe3\xc4\xe7\x17\xfa\x9f\x13\xaf\x2c\xf4\x53\x27\x3e\xfd\x9e\x2f\xc1\x03\x60\x9f\x27\xbe\x10\x1c\xf0\xa8\xb7\x32\x70\x51\x41\x7f\x3a\xf1\xe9\xf7\x85\x09\xbe\xc8\x79\x59\xa6\x80\x57\x15\xf8\x1f\x9c\x78\x35\x58\xd2\xe8\x85\x5f\x52\xd0\x9f\x4e\xfc\xd2\x82\xde\x74\xe2\x97\x79\xf6\xcb\x32\x70\x79\xe1\xde\x95\x13\xbf\xa2\xa0\x0f\x9d\xf8\x62\xcf\xf6\x59\xe6\x71\x9f\x9a\xe0\x4b\xa6\x61\x25\xf8\xfe\xd2\x09\xdb\x8d\x2f\xc4\xba\xb7\x58\x9e\xec\x2e\xd0\x35\x38\xcc\x62\xb0\x83\xca\x67\xfc\x21\x75\x3e\x72\x56\x53\x39\xc7\x5d\xf5\xb2\x96\xe2\x91\x2b\x9c\xb8\x40\xf1\xdd\x2e\xfc\x23\x38\xde\x62\xbe\x58\xb3\x4e\xe3\xdf\xd3\xcb\xf9\x33\x54\xce\x01\x97\x9c\x47\xb1\xff\xe9\xf5\xe5\x97\xfe\xa7\xb1\xff\x6a\x20\x34\xb9\x5d\xbc\xfd\x7f\x1b\xff\x9d\xde\x7e\x5e\xc0\x72\xa6\xd7\xe3\x49\x5a\x9e\xee\xfa\x2a\x2d\x21\xb8\xbb\x7e\xab\x30\x3e\xbd\xfd\x2c\xf1\xe1\x61\x58\xe5\xc3\x6f\xf0\x51\x1f\xfc\x7f\xf9\xc8\x79\xd1\x07\x2f\xf1\xe1\x2b\xb8\xc6\x87\x3f\x21\x55\xea\xcd\x6b\x31\xec\x23\xc7\x8f\xbf\xe2\x11\x1f\xfc\x44\xa9\x37\x3f\xc0\xdb\xa5\xde\xfc\x03\x0b\x7d\x78\x15\xae\x2b\xf3\x4e\xe7\xc6\x32\xef\x7c\x8d\xfb\xc8\xf9\x7b\x1f\x39\x5f\xf5\xf1\xff\x9d\x32\x6f\x1e\x8f\x57\x7d\xfc\xb7\xf9\xf0\x3c\x0c\xf8\xe0\xe9\x72\x6f\x9e\x84\xcf\xf9\xf8\xf7\xe3\xbb\xb8\xb6\xc2\x9b\xdf\xa3\xa9\xc2\xdb\xff\x66\x1f\xfc\x6f\x7d\xf0\xaf\x54\x78\xf3\x42\x3c\xeb\xe3\xff\xf9\x0a\x6f\xfe\x0a\xdb\xc7\xff\x75\xf3\xbc\xf1\xe8\x3c\x6f\xbe\x8b\xde\x79\xde\xf5\xfe\xc0\x3c\x6f\x1e\x8c\xa5\xf3\xbd\xfd\x37\xcc\xf7\x8e\xb7\x63\xbe\x37\x6f\x46\xc4\xc7\xff\xcd\x3e\xf8\x9d\x3e\xb8\xe9\x83\x7f\xca\x07\xff\x07\x1f\xfc\x2b\x3e\xb8\xe4\x83\x7f\x67\xbe\x37\x8f\x07\x28\xbe\xaf\x2b\x90\x02\x88\xf4\xcd\x9d\xf8\xe1\xee\x75\x94\x30\x00\xc4\x0c\xcb\xb4\xb2\x89\x44\x38\x06\x8a\x4c\x1d\xa2\x95\x16\x63\xaa\xae\xc9\x26\x10\xc5\xb8\x2e\x26\x55\x7d\x50\x52\xc5\xb8\xa5\x1b\xa6\x28\x65\x47\x40\x4c\x4f\x67\x54\xd9\x92\xe3\xe1\x75\xcd\x1b\x9a\xbd\x3d\x61\x0e\x10\x51\x32\x0c\x69\x54\x94\x35\xcb\x18\x05\x98\x78\x55\x8c\x67\xd3\xe9\x51\x20\x8a\x9c\x0d\x93\x86\x38\xbc\x8a\xe2\xa6\xad\xed\x3d\x5d\x62\x57\x6f\xa7\x28\x02\x51\xdc\xdc\xbb\x4d\xec\x12\x28\x28\x74\x6e\x05\xe2\xe6\xee\x2d\x37\xb5\x77\x33\x22\xdb\x81\xf6\x9b\xba\xbb\x90\xc7\x81\x9e\x8e\x42\xa0\xb8\xa9\x8b\xe4\xf3\xc0\x40\xec\xbc\xbd\xb7\xbd\x27\xda\x41\x5e\x6a\xb6\xb5\xf1\x97\xf3\xcf\x93\x04\x84\x0a\x2b\x5e\xda\x9f\x15\xf5\x88\x23\xd4\x2c\x88\x21\x5c\xb1\x44\x3c\x38\x4f\x5c\x22\x79\xfe\x8c\x62\x86\x49\x6c\xe7\x4c\xf9\x31\x3d\xb3\x2e\xfa\x17\xcc\xe1\xe1\x4c\x89\x3c\xa2\x58\xee\x12\x3f\x6f\x4a\x8d\xe9\x09\x21\x94\x33\xbe\x6c\x31\xae\x14\xcc\x44\x4d\x73\x7e\x7c\x2c\xd3\x52\x46\x98\x26\x3c\xe2\xf7\x8c\x9d\xbe\xbe\x77\x79\x77\x70\xe2\x5c\xa0\xc2\xe4\x69\x4f\xbc\x12\x6d\xe9\xaa\x33\x26\x8e\x3e\xc3\x23\x09\x67\x4f\x36\xe2\x64\xa2\x99\xde\x23\x2e\x18\xb5\x8c\x33\x77\x1b\xbc\x19\x53\x28\xed\x89\x47\xce\xce\x93\xe0\xc7\xdd\x2f\x9d\xfc\x32\xee\x8a\xe6\x18\x45\x5c\x44\x2f\x45\x9f\x6b\xce\x48\x84\x32\x9d\x1e\xc7\xa3\x78\x7d\x68\x66\x5c\x3e\x65\x2d\x7e\x56\x14\x43\xee\x88\xfe\xe8\x48\x64\x08\xc1\x90\xb3\xdc\x09\x0d\x8c\xab\x2e\x28\xc9\xcb\x87\xb5\x61\xa7\xba\x29\x1c\x7a\xf1\xea\x05\x45\x8e\x22\x67\x18\x42\x01\x54\x0c\xd1\xb8\x86\x9c\xe1\x70\xca\x28\x50\xd6\xb8\x44\x63\x56\x2d\x10\x36\x47\xd3\x96\x34\x08\xc2\xa8\x77\x62\x33\xc5\x7e\x69\xba\x25\x87\x93\x5a\x36\x8c\xc9\x47\x43\x4a\x1c\x60\x5b\x4a\x32\x53\x20\x1c\x1f\xd5\xcc\xd1\x34\x31\x2d\x83\xb8\x50\xce\x69\x87\x45\x34\x40\xd8\x90\x55\x09\x79\xa4\xbf\x32\xaa\x05\xc2\x38\x76\xf4\x33\x9c\xd4\x2d\x10\xb6\xe4\x11\x0b\x84\xb1\xbe\x0d\x1b\x3a\x2e\xa4\xb0\x9c\xa2\x03\x7a\x2a\x6e\x14\x6d\x24\x28\x19\xd9\x49\x08\xf6\x9b\x52\xdf\x02\x24\x91\x44\x42\xe4\x0c\x9a\x26\x08\xc7\xf4\x74\x5a\xd6\x2c\x8f\xe9\xce\x59\x3f\x4b\xe9\x9e\x25\xdb\x8e\xf1\xe3\xad\x64\x4f\xb9\xcb\x1e\x46\x6b\x48\xdb\xd6\x59\x78\x37\x3f\xa6\x40\x71\xb6\x9f\x59\xe9\x0a\xbf\xbe\xb0\xd6\xa7\xf6\x52\xa7\x79\xa4\xbc\x18\x6f\x09\x17\x9e\xed\x24\xb4\x51\xd9\x85\xed\xa4\xf9\x4e\xf3\xe3\x2e\xf2\x50\xf7\x76\x94\x40\xf7\x5c\x59\x78\xb6\xcf\xc4\xcc\x84\x2b\xfd\xa5\x2e\x73\x1b\xdd\xc3\x65\x76\xb6\x1f\xc5\xcc\x03\xa0\x98\xfe\x32\x8f\xfc\xc7\xa9\x1b\x0b\xcf\xf6\xad\x98\xc9\xf6\x8d\xdd\xe5\xc7\xf2\xaf\xd1\xf0\x37\xb1\xf2\xa8\x72\x9a\x6c\xdf\xba\x8c\xf2\xa0\xb8\xc3\x8f\x70\x5c\x9d\x80\xdb\xa7\x67\xe6\x95\xae\xf4\xba\xeb\xdf\x74\x85\x67\xfb\x65\xcc\x8c\xb8\xf6\xf9\xaa\x5d\xe6\xbd\xae\xf0\x6c\x5f\x8d\x99\xee\xf2\xaa\x74\x99\xf7\xbb\xc2\xb3\xfd\x22\x66\x5e\x7b\x95\x77\xfc\xec\x99\x70\x85\x67\xeb\x76\x66\x2e\x38\x43\xfe\xff\x9a\x86\x2f\x6c\xcf\x84\x9d\xe6\xd1\x52\xef\xf4\xb3\x76\xf8\x59\xfa\x9b\x79\xf3\xe3\x7f\xf5\x8b\xff\x1f\x5d\xe1\xd9\xfe\x20\x33\xd7\x94\x78\xc7\xcf\x9e\x7f\xa5\xef\x6c\x58\xfb\x2a\xf2\xc1\x12\xbb\xbb\xbc\xdc\xe5\xff\x34\xe5\xef\x61\xe1\xd9\x3e\xd8\x93\xad\xde\xf1\xb9\xc3\xff\x9b\x8b\xcb\xa9\xc8\xb7\x4b\xec\x4c\x8f\x94\xbb\xc2\xb1\x74\x7d\x92\xe6\x9f\x85\x67\xfb\x97\x8f\xdf\x48\xec\x7d\x67\x88\xff\x07\xae\xf0\x85\xfd\x31\xba\xb1\x58\xe7\x53\x7e\xcc\xfc\x4f\x57\x78\xb6\x5f\xd6\xd6\x4e\xec\x3b\x5c\xf1\xbb\xf7\x62\x5e\xa2\xb2\x58\x78\xb6\xfe\x7e\xb4\xdd\x19\x0f\x7b\xdc\xfa\x2b\x4f\x31\xf7\x76\x31\x0b\xef\xd6\xdf\x25\x1e\xa6\xc7\x56\x3c\x38\x42\xc3\x1f\xa5\x09\xb8\x9a\x9e\x2d\x74\xeb\x8f\x05\x2e\x1e\x2e\xf6\x3c\x7c\x33\x31\x7f\x30\x7f\xe6\xf4\x5f\xe2\x13\x5e\xdd\x4a\xcc\x13\x3e\xe9\x9f\x7b\xfe\xdf\x78\xce\xcc\xff\x7e\x2e\x5f\x7c\x73\x3e\xe7\xf0\xfd\xcf\xd5\xab\x9b\xe6\xbe\xff\xf5\x7e\x3c\x67\x55\xff\xb3\xfe\xe2\x9b\xf3\x39\xfb\xfa\x5f\xdd\x1c\x59\x3b\x57\xff\xef\xc7\x73\x2e\xf5\xcf\x3e\xc1\x31\xdb\x38\x66\xfe\xfe\xcb\x9a\xc8\xea\x75\xee\xfa\x5f\xdb\xb4\x66\xee\xfb\x2f\xef\xcb\xe3\xfe\xfe\x43\x09\xfd\xfe\x83\xfb\x19\x69\x29\xfe\x6e\xa3\x7f\xaf\x06\x57\x61\xbf\x17\xd3\x39\x6a\x09\x9d\x6b\xf2\x73\xd2\xb3\xe5\x23\x03\xf8\xca\x97\x83\x8f\x0c\x90\xeb\x4f\x1c\x1f\x19\x00\xe7\xc6\x47\x06\x28\x3f\x8b\x07\x1f\x19\x76\x1b\xc7\x8b\xd1\xee\x71\x3c\xb7\x7d\x3f\xf9\xc8\xb8\xd8\xbd\xf9\xc8\x90\xcb\x4c\x7c\x64\xd4\xdd\xcd\x47\xc6\xc9\x3d\x77\x3e\x32\x52\x25\x58\x19\x17\xf8\xc8\x78\x6c\x1e\x8f\x9d\x37\x1f\x19\x2f\xb9\xc4\x23\xb6\xcb\x3c\xb0\xa0\x07\x76\xa5\x03\x9b\xe3\x23\x9b\xe3\x23\x9b\x89\x8f\x8c\xb6\x92\x0b\xc2\x47\x46\xd5\x8c\x2f\x1f\x19\xd1\x31\x8c\x8f\x0c\xd9\x9c\x7c\x64\xa4\xd3\xfe\xa9\xf0\x91\x71\x2a\xaa\xc8\x47\x06\x80\x83\xe2\x08\x14\x29\xb3\x66\xc7\x47\xe6\x10\xba\x96\x18\x3e\x7c\x64\x44\x6b\x9d\x2d\x1f\x19\xd1\xa8\x98\x8f\xac\x30\x0a\x70\x3a\xf7\xcc\x7c\x64\x5c\xa8\xc7\x59\xa8\x73\xe0\x23\x43\xc1\xce\x8d\x8f\x0c\xfd\x39\x07\x3e\x32\x5a\x15\xb3\xe0\x23\x23\xcd\xfc\xf7\xcc\x47\x46\x92\x73\x96\x7c\x64\x5c\x1e\xce\xc0\x47\x46\x7c\xce\x96\x8f\xcc\x53\xae\x27\x1f\x99\x43\xee\xef\x99\x8f\x8c\x16\x91\x17\x1f\x19\x75\xe2\xf9\xc8\x48\x99\xe1\x09\x10\xe1\x23\x03\x94\x3f\x8b\x60\x8e\x9e\x45\xfa\xa9\x9b\x8f\x0c\x30\xaa\xb1\x7c\x21\xc0\x05\xe6\x23\xc3\x4d\xd7\x87\x8f\xac\xe0\x46\xf9\xc8\x8a\x89\x3e\x5b\x3e\x32\xdc\x8f\xff\x74\xf9\xc8\x58\xbd\x53\x3e\xb2\xc2\xa0\xe6\xc3\x47\x46\xdb\xfe\x0c\x7c\x64\xb4\x66\x78\x3e\x32\x0a\xf9\xf1\x91\x15\xea\x92\xf2\x91\x21\xbb\x37\x1f\x59\xc1\xa7\x9b\x8f\xac\xe0\xe0\xe2\x23\x23\xea\x77\x16\x7c\x64\x34\x5f\x33\xf1\x91\xd1\x5e\xe4\xc3\x47\x86\x5c\x31\xc5\x10\xd7\x73\x7c\x7b\x4b\x62\x5f\x9e\xeb\x59\x17\x80\x8f\x8c\x42\x0e\x3e\x32\xae\x8b\x3b\xf9\xc8\x5c\x69\xf1\xe1\x23\xe3\xf2\x71\xbe\x7c\x64\x44\x94\x1f\x1f\xd9\xb4\x45\x91\x17\x1f\x19\x1d\x4b\x66\xc5\x47\xe6\x58\x0d\xcd\xc8\x47\x46\x17\x45\x33\xf0\x91\xf1\x4b\x0d\xc6\x47\x46\x1b\xc2\xd9\xf1\x91\xf1\x81\x66\xc1\x47\xc6\x79\x3f\x4f\x3e\x32\x52\x0c\x73\x7c\x64\x67\xe0\x23\x23\xd3\x18\x7f\x3e\x32\x56\xc6\x4e\x3e\x32\x52\x4d\xd7\xf2\x5d\xcd\xc1\x47\x46\xdc\x0b\x7c\x64\x5c\x98\x8d\x05\x65\xc1\xf8\xc8\x9c\xdd\x92\x9f\xbf\x11\x1a\x2d\x3c\x75\xfc\xf1\x99\x54\x0b\x1f\x8e\x11\x70\xa1\xdf\x7f\x32\x7c\x64\xee\x8c\x13\x3e\xb2\x69\x28\xcf\x47\x86\x01\x3f\x3e\x32\x3a\x32\xcc\x82\x8f\x8c\x34\xa1\x59\xf1\x91\x79\x0a\xf5\xe4\x23\x73\x0a\xfd\x43\xf3\x91\xb1\x99\xa4\x8b\x8f\x8c\xcd\x3d\x3d\xf8\xc8\x0a\x8d\xf1\xac\x19\x88\x02\xff\x95\x38\x88\xe6\xf8\x87\xfe\x6b\xf1\x0f\x6d\xa3\x4d\x1b\x7f\x96\x03\x4c\x7f\xfe\x50\x7c\x7b\xa4\x27\x9e\x1d\xdf\x1e\x09\x33\x2b\xbe\x3d\xa2\x8d\xa6\xf3\xed\x21\xfc\x7d\xe0\xdb\xf3\x2e\xdf\x0b\xc5\xb7\x47\xca\x61\x8e\x6f\xef\x8f\x82\x6f\x0f\xb7\x28\x37\xdf\x1e\xa9\xa1\x3f\x46\xbe\x3d\xf7\xfd\xdf\xb3\x7e\x66\x79\x01\xd8\x8f\xaf\xac\x9e\xbb\x93\x3f\x5b\xbe\xb1\x6e\xea\xdf\x8f\x6f\x4c\xa5\xee\x67\xc3\x37\xf6\x28\x0d\x33\x5b\xbe\xb1\xa3\xd4\xff\xd9\xf2\x8d\xf9\xdd\x57\x05\xf4\x5e\xb3\x37\xee\xfd\x05\xfb\x32\x9f\x5b\xfc\x65\x5e\x97\xa0\xe9\xfd\x65\x6f\xdc\x7d\x4a\x91\x9d\xa9\x2d\xe2\xce\xfb\xb3\xc5\x9b\x61\x6d\x0e\x39\x0b\x7d\xe4\x7b\x5c\xa6\xa6\xf7\x94\xbd\x71\xaf\x9b\x67\xe4\x7e\xb1\x37\x7e\xc5\x34\x8c\x7c\xeb\x6d\x7a\x39\x84\x28\xee\x3a\xd6\x49\xcf\x5f\xce\x77\x7c\x3b\x11\x3d\xeb\x28\xee\xbe\x36\xfd\x21\x8a\xaf\x76\xe1\xe4\xd8\x5b\xe5\xb4\xf3\x74\x9b\xa8\xff\xcd\x2e\xfc\x16\xea\x7f\x9f\x0b\xef\xa3\xfe\xdd\x87\xb4\x6f\xa5\xb8\xe8\xc2\xef\xa0\x72\x9e\x74\xe1\x83\x14\x77\x9f\x23\x4c\x52\xdc\xfd\xa8\x54\xfe\x7d\x2e\xdc\xa0\xfe\x97\xb8\x5e\xbe\x8f\x50\xfc\xb8\xcb\xff\xbd\x54\xce\x67\x5c\xf8\x5e\x8a\xff\xd4\x85\xff\x05\xc5\xff\xc3\x85\xef\xa3\xf2\x1f\x77\xc5\xfb\x10\xf5\xff\x9f\x2e\xff\xff\x9d\xe2\xff\xe0\xc2\x3f\x47\xe5\xb4\xb9\xe4\x3c\x46\xf1\x11\x17\xfe\xbf\xa8\x9c\xff\x0d\xa6\x3f\x65\x8e\x2f\x48\xf2\xb8\xf7\xe9\xc2\x32\x8f\x13\x92\x5f\xc2\xdf\xfb\x2c\xf6\x77\x76\x9f\xf7\x88\xc7\x99\x59\x80\x3f\x3e\xe7\x8d\xff\xdc\x07\x7f\xd9\x07\xff\x8d\x0f\x5e\x52\xe2\x8d\xaf\xf4\xc1\x93\x3e\xf8\x17\x7c\xf0\x63\x3e\xf8\x55\xa5\xde\xf8\xf5\x3e\x78\xc2\x07\xff\x92\x9f\x1c\x9f\x7b\xbe\xff\xdb\xc7\xff\x33\x3e\xf8\x51\x1f\xfc\x45\x1f\xfc\xb7\x3e\x78\x4b\x99\x37\x7e\x8b\x0f\xfe\x57\x3e\xf8\x43\x3e\xf8\x57\x7d\xf0\x1f\xfa\xe0\x3f\xf5\xc1\x8f\xf9\xe0\x25\xf8\xfe\x75\x51\x9f\xb3\xfb\xd7\xcd\xe5\xde\xfe\x3f\xe4\x83\x77\x62\x39\x45\xfd\xcf\xee\x1d\xff\xa8\x9c\xf4\x8b\x6a\xda\x1f\xd8\x3d\xe8\xb7\x7d\xe4\x9c\xf6\xc1\x2b\x7c\xea\xbd\xca\x07\x5f\xec\x83\xd7\xf8\xe0\x41\x1f\x3c\xe2\x83\xcf\xf6\xbe\x70\xb8\xbb\x23\x82\xfe\x34\xa1\x3f\xab\xd1\x9f\x35\xe8\xcf\x5a\xf4\xa7\x19\xfd\x59\x87\xfe\x6c\x40\x7f\x1a\xb1\x9f\x46\xec\xbd\xb1\x11\xfd\x5d\x8f\x7f\xe2\x3f\x38\x40\x23\x0e\xdb\x88\xc5\x34\xe2\xd0\x4d\x38\x4c\x13\xf6\xd3\x84\xf1\x26\xe2\x07\x8b\x6d\x24\x02\xb0\x74\x7c\x05\x76\xfa\x65\x4f\xef\x7b\xc1\xe4\x0a\x68\x61\xb3\xc0\xe3\xf2\xec\xec\x6e\xed\x9e\xef\x5d\xe1\x0b\x72\x9f\xf0\x7c\x2f\xa8\x9e\xd5\x6d\xbe\x69\xd7\x34\xcf\xe7\xf6\xf0\x85\xbd\xd5\x49\x2f\x1b\xd3\xfb\x7c\xce\x0b\xcf\xd3\x2e\xe3\x79\xdc\x88\xbc\x30\x37\x67\xa7\x5d\xe8\xbc\x10\xb7\x9b\xe9\x6d\xc1\x59\xdc\x0b\x9f\xf1\x26\x27\xbe\x7a\x3d\xed\x1e\xa9\xf7\xf5\xd7\x73\xbc\xc3\xab\x0d\xa7\xd9\xcd\xd9\x3f\xc6\xbb\x9e\x67\xba\x27\x8b\xb7\xb1\xd8\x76\x65\xf1\xf6\x25\xbd\xfe\xcc\xdf\x78\xa6\xd7\xb8\xe9\x4d\x51\xb2\x31\xc9\xdd\x4b\x29\xa7\x57\xa2\xde\xa3\xcb\xdb\x0e\x0e\x6f\xe0\xf0\x6d\x14\x2f\xa5\xf3\x1c\x86\xff\x25\xe7\x3f\xc2\xe1\x8f\x71\x78\x23\x87\xff\xff\x29\x7e\x3d\x5d\x1b\x30\xfc\xcb\x1c\xbe\x9a\xc3\x57\x70\xf7\x8c\xd6\x70\x78\xa8\xa4\xe8\x7f\x2d\x87\x6f\xf6\xc1\x93\x1c\xde\xcc\xe1\xf7\x72\xf2\xd7\x71\xf8\x18\xe7\x7f\x3d\x87\x3f\xeb\x93\x9e\x6f\x95\x14\xcb\xe7\x12\x0e\xff\x11\xe7\x7f\x03\x87\xff\x9c\xf3\x7f\x29\x87\xbf\xe6\xe3\xbf\xb1\xd4\x1b\x67\xf7\x31\x4b\xe9\xf7\xd7\x19\xde\xe5\xe3\xbf\x9b\xf3\x7f\x39\x87\xdf\xee\xe3\x7f\x07\xe7\xff\x0a\x0e\x4f\xfb\xf8\xb7\x38\xff\x8b\x39\xfc\x13\x3e\xfe\xff\x27\x77\x8f\xb4\x85\xc3\xdf\xe4\xf0\x8d\x1c\x7e\x59\x45\xb1\x5e\x3e\xc4\xe1\x1f\xac\x28\xc6\x7b\x25\x87\xaf\xae\x28\xca\x69\xe5\xfd\xcf\xf3\x8e\xb7\x6e\x9e\xb7\xfc\xe6\x79\x45\xf9\x4b\xf8\x72\x9e\xe7\x2d\x7f\xc8\x47\xfe\xc7\xe6\x79\xb7\xcf\xbd\x3e\xf8\xff\x98\xe7\xdd\x0e\x7f\xe4\x23\xff\x17\xf3\xbc\xdb\xf9\x1b\x9c\xff\x1b\x38\x7c\xc9\x7c\xef\xfe\x58\x37\xbf\xe8\xff\x46\x0e\x17\x39\xbc\x8d\xc3\xff\x96\xc3\xdb\x39\xfc\x28\x87\xdf\xc4\xe1\xef\x72\x78\x07\x2f\xbf\xd2\x5b\xfe\x67\x2a\xbd\xe5\x7c\xad\xd2\x5b\xce\x31\x1f\xff\x8b\x17\x78\xfb\xff\xe4\x02\xef\x78\x1f\xf1\xc1\x1f\x5f\x50\x6c\x0f\x57\x71\xf8\x57\x38\xfc\x6a\x0e\x3f\xc8\xc9\xe9\xe4\xf0\x7f\xe3\xfc\x2f\xe5\xcb\x8d\xf3\xdf\xc5\xd7\xfb\x02\xef\xf6\xf9\x73\x4e\xce\x35\x7c\xbd\x2f\xf0\x6e\x9f\xef\x71\xf8\x26\x0e\x2f\x09\x14\xf1\xcd\x1c\x3e\xc0\xe1\xbd\x1c\x9e\xf1\xc1\x77\x07\x8a\xe9\xb9\x96\xd7\xab\x9c\xff\x2d\x1c\xfe\x14\x87\xf7\x71\xf8\x57\x17\x7a\xb7\xf3\x23\x0b\xbd\xfd\xe7\x39\xfc\xcf\x38\x3c\x70\x51\x11\xdf\xca\xe1\xd7\x5e\xe4\x9d\xfe\x0f\xf9\xe0\xdd\x1c\x5e\xcf\xe1\x09\x1f\xff\x1f\xf7\xc1\xff\x86\xc3\xfb\x39\xfc\xc0\x45\xde\xed\xf6\xa5\x8b\xbc\xdb\x6d\xc9\xc5\xde\xfd\x2e\x70\x71\xb1\xfc\x3f\xc0\xe1\x97\x5c\xec\xdd\x7e\xe0\xc5\xde\xed\xe4\xfa\x8b\xbd\xdb\x49\xa3\x4f\xbc\xeb\xb9\x78\xaf\xe3\xcb\x81\xf3\xdf\xcd\xe1\x7f\xed\x93\x9e\xbf\xe3\xe4\x40\x0e\xff\x02\x27\x67\x80\x9f\x3f\xf8\xa4\xf3\xfb\x1c\xce\xcf\x43\x7e\xcc\xc5\xcb\xeb\xbd\x6b\x17\x15\x71\x7e\x1e\xb2\x71\x51\x51\x0e\x3f\xee\xdf\xc4\xf9\xe7\xf5\xf6\x0e\x0e\xe7\xf5\xf0\x5f\x72\x72\xf8\xf9\xc6\x5f\x73\xfe\x79\x3d\xff\xe5\x45\xc5\x72\xf8\x20\x87\x3f\xe7\x93\x9e\xe7\x39\xff\x35\xbc\x3e\xe4\xfc\xf3\xe3\xef\x07\xab\xbc\xf1\x3e\x1f\xfc\xef\x38\x3c\xca\xeb\xb7\x6a\xef\x7e\xfa\x62\xb5\xb7\xff\xb7\x7d\xf0\xa9\x6a\xef\xf6\x50\x71\x49\x31\x5f\xcb\x38\xfc\xca\x4b\xbc\xdb\xc3\x0a\x0e\xdf\xc6\xe1\xab\x2e\xf1\x6e\xb7\xeb\x38\xf9\xb5\xfc\x7c\xf8\x12\xef\x7e\xd1\x73\x89\x77\x7b\xbb\xdd\x47\x7e\x8c\x93\xbf\x9c\xc3\xd5\x4b\xbc\xf3\xfb\x51\x9f\x78\xc7\x7c\xe2\xfd\x94\x4f\xbc\x8f\x70\xf1\xae\xe0\xf0\xbf\xb8\xd4\xdb\xff\x43\x97\x16\xfd\xaf\xe4\xf0\x47\x7d\xfc\x7f\x81\xf3\x5f\xc7\xe1\xfb\x39\xff\xfc\xb8\xf9\x13\x0e\xe7\xf5\x5b\xf9\x65\x45\x9c\xd7\x6f\x0d\x97\x79\xfb\xbf\xdd\xc7\xff\xaf\x2e\xf3\x8e\x77\xca\x07\xbf\xea\xf2\x62\xfa\xf9\xf1\xf7\x03\x97\x17\xfd\xf3\xe3\x2f\xe3\x3b\x28\xa5\xf7\xdf\x18\xce\x76\xee\xca\x29\x77\x09\xc3\x29\x8c\x77\xb0\xf9\xb7\x26\x23\x9c\xff\x9b\xf9\xf2\xe4\xf0\x5b\xf8\x71\xdf\x07\x7f\x1d\x78\xf7\xbb\x01\x1f\xf9\x2f\x96\x78\xfb\x3f\xe9\x83\x57\x73\xe9\xaf\x60\x47\x77\x38\x9e\x01\x84\xf7\x70\xf9\xaa\x73\xf9\x7f\x8b\xda\x21\x57\x6e\xfc\xae\x7d\x1f\x87\xf3\xe5\x93\xe1\x70\xfe\xed\xd7\x3f\x70\x38\x4f\x31\xfc\x03\x97\x7c\xb6\xcf\x39\x13\xf7\x10\x26\x0a\x22\xa4\x40\x1c\x89\x0f\x61\x06\x42\xde\x1b\xc3\x8d\x4e\xeb\x7a\x2e\x4c\x18\x93\x5d\x65\x33\x14\xe2\xf9\x82\x8a\x7e\xce\x69\x73\xc2\x2f\xfc\x1f\xc7\xce\x04\x25\x5a\x42\x65\x62\xc8\x6a\x91\xf3\x88\x30\x39\x6d\xee\xdd\x16\xc2\xbb\x36\xd4\x5f\x91\x50\x29\x69\xe8\xd9\x0c\x38\xef\xe7\x01\x1f\x7e\x5a\xf6\xbe\x94\xb5\x89\x2b\xe9\xb8\xc2\xfa\x6b\xf9\x19\xc2\x77\x7b\x84\xef\xf6\x08\x0f\x5d\xbc\x34\xec\x61\xef\x01\x6b\x5c\xaf\x52\xdd\x7b\xd6\x4b\x39\x1d\xe4\x78\xe8\x0b\x52\x36\x7f\xba\xd2\xc5\x4b\xc4\xfa\xdb\x0a\x37\x2f\x0a\x7d\x3e\xe9\xc3\x57\xee\x7e\x73\x56\xef\xe2\x35\x39\xdb\xf0\x8d\x6e\x5e\x1d\x57\xf8\xf7\x7c\xc2\x33\xb3\xcd\x27\x3c\xe3\x75\xfe\xae\x0f\xaf\x0b\x83\x07\x7c\xca\xbf\x8f\x12\x22\xb9\xeb\xd6\x5d\xfe\x5b\x7c\xca\x7f\x3d\x79\x21\x5d\xd8\x17\xbb\x92\x72\xa8\xb8\xcb\x3f\xe9\xe2\xe5\x61\x4f\xea\x4a\x67\x7a\xdd\xe9\x67\xe6\xa0\x4f\xfc\xa9\x75\xce\x78\xae\xa4\x7a\xcf\x97\x17\xca\xf5\xea\x33\x43\xe3\xbf\xb5\x64\xe6\xfc\x9b\x2c\x7e\x57\xf8\xdd\x34\xfe\x1d\x5c\xfc\x17\x7b\xc4\xff\xa4\x4f\xfc\x47\xa9\x87\x09\x1f\x5e\x29\xf6\xfc\xab\x4f\xfc\x2f\xd0\xf8\x05\x2e\xfe\x2a\x8f\xf8\x6f\x2d\xf1\x6e\xff\x3b\xe8\x07\x2a\xdc\xe5\xea\xbe\x90\xff\x67\x25\xde\xe5\x5f\xb7\x7e\x7a\xfc\x97\x78\xc4\xbf\xa3\xc4\x87\xd7\x87\xc6\xbf\xdc\x85\xbb\xdb\xbf\x52\xe2\xcd\x95\xf3\x8d\xa5\x1e\xa0\x47\xff\xbb\xb7\xc4\x9b\x1b\xe8\x08\x0d\xef\x3e\x2f\xe0\x6e\x8f\xa3\x3e\xf9\xcf\xd0\xfc\xb3\x79\xc9\x95\x3e\xbc\x64\x7e\xbc\x44\x4f\xd2\xc3\x46\xd5\x95\x45\xff\x67\xc3\x4b\x74\x84\x12\x22\x35\x9f\x81\x17\xce\x8f\x97\x28\x4f\xd3\xff\x57\x25\x33\x87\x9f\x7b\xc8\x73\x0e\xfc\x1f\x61\x14\xe6\x6c\xe2\x98\x99\xff\x65\xed\xda\xc6\x35\x6e\xfe\x8f\x35\x6b\x9a\xe7\xf8\x3f\xde\x97\xc7\xcd\xff\x51\x46\xf9\x3f\x18\x3f\x1f\xd3\x0f\x3b\xfa\x8b\x61\xda\xc0\x7a\x30\x1f\xb4\x81\x6b\xc0\xd2\xc2\xfc\xde\xef\xe9\x6e\x72\x9a\x4c\x13\xb3\xb1\x9b\xf1\xd8\x15\x79\xed\x88\x79\x8a\x75\xee\x72\x67\xb8\x52\x1a\x8e\xf1\xcf\x15\xf9\xe8\x88\xe9\xe6\xb7\xac\xe4\xc4\x94\x7b\xf0\x67\xba\x79\x34\x6b\x5d\xd1\xf6\xbd\x6c\xc5\xcb\x3d\x78\xff\xdc\xfc\x7f\x9d\xae\x70\x7f\xf6\xb2\x15\x3f\x9b\x6f\x00\xb1\xf1\x71\x2b\x8d\xcf\xaf\x5c\xb6\x53\xa5\xcb\xcc\x12\x2e\xde\xcb\xa9\x4e\xdc\xdc\xbb\x0d\xdc\x7c\x73\x67\xdb\xf6\x67\x1f\x5b\x7c\xb8\x33\xfc\xca\x97\x32\x7f\x25\xae\x1e\x2b\xc5\xbc\x4d\x65\x74\x8a\x59\x28\xff\x17\x60\x59\x49\x85\xb4\xf0\x7a\xfa\xfe\x0f\xcd\xeb\x96\xfc\xec\x8d\x8a\xa7\x5e\xfa\xf4\xae\x1d\x97\xdd\xb0\xaf\xa3\xeb\x3f\x8e\x75\xf6\xfc\xed\xa9\xe7\xee\xde\x7e\xcd\x57\x27\x4e\x2c\x7a\xf5\xdf\x5f\x5f\xa4\x4c\x3d\xf2\xa2\x5f\x3e\x0e\xfb\x7c\x0f\xe0\x6b\x1e\x73\x32\xf4\x3c\xec\x83\x87\x7c\xbe\x67\x30\x06\xbc\xf1\xdb\x7d\xbe\xf7\x70\xd0\x67\x9e\x5f\xe2\x31\x27\x40\xcf\x2d\x3e\x72\xd2\x3e\xe9\xf9\x85\x8f\xff\xac\x4f\x3a\xaf\xf1\x89\xb7\xc3\x27\x9d\x5f\xf5\x29\xcf\xf5\x3e\x72\x3e\xe3\xe3\x7f\x2b\xf0\xfe\x6e\x84\xdf\xf7\x33\x3e\xef\x23\xe7\x8b\x3e\xf8\xe3\x3e\xdf\xff\x38\xed\x83\xef\xf1\x39\xff\xf4\xef\x3e\xe5\x3c\xe5\x53\x9e\xdf\xf3\x49\xbf\xe1\x23\x67\xc4\xc7\x7f\x9f\x8f\xff\x3d\x3e\xf5\xb2\xd5\xe7\xfb\x25\x7e\xdf\xc3\x38\xe8\x83\x7f\xdb\x27\xde\x5d\x3e\xf9\x4d\xfa\xa4\x7f\x85\x8f\x9c\xff\xe6\x13\xef\x7f\xf7\xc1\x77\xfb\xc4\xab\x95\x7b\x7f\x3f\x63\x41\x85\xf7\xf7\x30\x7e\x5a\xe6\xfd\xdd\x8b\x93\xe5\xde\xdf\xb1\x90\x80\xf7\xf7\x57\xc8\xf9\xb3\xe9\xdf\xf9\xa8\x06\xde\xdf\x8d\x58\x0c\xbc\xbf\xef\xf2\x70\x99\xf7\x77\x4d\xa6\x7d\x00\x00\x73\x45\x53\x32\xfe\x99\x48\xf6\x7d\x09\xf0\x9d\x5c\xf7\x33\x30\xfa\xcf\x1d\xe7\x9a\x3b\xce\x35\x77\x9c\x6b\xee\x38\xd7\x85\x3b\xce\xa5\x2a\x83\xa6\x15\x8f\xad\x5a\x15\x36\xf5\x70\x33\xb2\x66\xac\x14\x92\x82\xec\x11\x64\x8f\x11\x17\xf6\x69\x00\xfe\x6b\x0d\xb2\x16\x07\xfc\x02\x8f\xe7\xef\x77\x7e\x7e\xc2\xf5\x25\x17\xfe\x2b\x01\xae\xaf\x70\x70\x1f\x0b\x28\x7e\xfb\x81\xff\x94\x0b\x9e\xd1\x97\x81\x72\x50\x01\xca\xc1\x3c\x3c\x3b\x2d\xc7\x48\x69\xe1\x5c\x7f\x29\xb5\x97\x82\x72\xca\x84\x5f\x0e\x2a\xc1\x02\x50\x8e\xff\x21\xbc\x12\x9b\xe5\x20\x00\x2a\xb1\xbd\x64\xda\x3f\x80\xff\xfe\x8c\x9e\x65\x46\x23\x49\xf6\x6a\x65\x01\x5a\x1d\x5c\x4d\x17\x2f\x25\xa0\x1c\x7f\x43\xb5\x9a\xbe\x8b\xc8\x7d\x3c\x58\x0a\xc0\x7c\xb0\x82\x9e\x37\xce\x9c\xfa\x9b\x52\xb4\xd2\x59\x43\xed\x56\xf0\xd3\x95\x28\xad\x1d\xd4\x7e\x37\x76\x2f\x03\x5b\x38\x79\xc7\xb8\xb3\xd3\x9f\xf9\xd4\x43\xf3\x00\x08\x80\x8f\x50\xff\x97\x2b\xca\xc5\x00\x2c\x00\x0a\x93\x77\x15\x4a\xcf\x3c\x60\x52\x3b\x49\x5f\x69\x21\x7d\x6c\xfe\xcf\xd6\x33\xec\xbb\x75\x8f\xbb\x70\xb6\x6e\x7b\xd2\x85\xb3\x7d\x55\xc6\x7f\xcd\x70\x66\xcf\xb8\x70\xf6\x9d\xca\x07\x5c\x38\xfb\x4e\x25\xe3\xf1\x9e\xe7\xda\x07\x7a\x92\xc3\xf9\xfd\x99\x03\x1c\xce\xbf\xdf\x39\xc2\xe1\xfc\x1e\xd5\x51\x0e\xe7\x6f\x37\x3d\xc7\xe1\x8b\x38\xfc\x05\x0e\xe7\xe7\x95\x79\x0e\xe7\xa7\x0a\xc7\x39\xfc\x3a\x0e\x3f\xc5\xe1\xfc\x8c\x63\x84\xfb\xae\x20\x7f\x87\x69\x09\xe5\x23\x9f\xef\xda\x63\x82\x1c\xce\x73\xc2\xd7\x71\x38\xbf\x6e\x8e\x70\x38\xbf\x6e\x5c\xcf\xe1\xfc\x2d\xb2\x36\x0e\x0f\x70\xb8\xc0\xe1\xfc\xfb\xb2\x3e\x0e\xe7\x3f\x69\xb9\x9d\xc3\xf9\xf7\x68\x3b\x38\x9c\xbf\x45\x96\xe2\x70\x7e\xfe\x9b\xe1\x70\xfe\xf6\xce\x08\x87\xf3\xaf\x16\x76\x73\x38\x7f\xbb\xec\x01\x0e\xe7\xdf\x0d\xec\xe3\xf0\xc5\x1c\xfe\x30\x87\xf3\xdf\x42\x78\x94\xc3\xf9\xaf\x5a\x3e\xc6\xe1\xfc\x1d\xb5\xc7\x39\xfc\x6a\x0e\x7f\x92\xc3\xf9\xad\xd9\x03\x1c\x7e\x0d\x87\x1f\xe1\xf0\x6b\x39\xfc\x28\x87\x7f\x80\xc3\x9f\xe3\xf0\x7a\x0e\x7f\x81\xc3\x3f\xc8\xe1\x79\x0e\xe7\xd7\x73\xc7\x39\x7c\x19\x87\x9f\xe2\x70\x07\xd7\x7c\x7b\x11\xe7\xf7\xaa\x2b\x39\x7c\x05\x87\x57\x73\xf8\x4a\x0e\x5f\xc2\xe1\x75\x1c\x0e\x39\x3c\xc8\xe1\xc2\x9e\x63\x95\xc2\x78\x45\x4f\x08\x02\x61\xec\x80\x55\x6a\x1f\x15\xf6\x7c\xbd\xf2\xd9\x82\xbb\xbd\xd6\x08\x41\x60\x2f\xb7\x42\x10\xf3\xe6\xd8\xcb\x91\x3d\x85\x9c\x26\x5f\xb0\x6d\xdb\x5e\x7e\x17\xb2\xa3\xae\x36\x79\x14\xdb\x07\x91\x1d\xa9\xa0\xc9\x03\xd8\xfe\x61\x64\x47\xaa\x67\xf2\x71\x6c\xdf\x8a\xec\xa8\x0b\x4e\x3e\x8a\xed\x37\x23\x3b\xea\x7a\x93\xfb\xb0\xfd\x26\x64\x47\x5d\x6e\x72\x37\xb6\xb7\x20\x3b\x4a\xf6\x64\x06\xdb\x9b\x90\x1d\x5f\x4e\xdf\x81\xed\xd7\x23\x3b\xea\x8a\x93\x7d\xd8\x5e\x83\xec\xa8\x0b\x4e\xb6\x61\xfb\xd5\xc8\x8e\xba\xde\x64\x04\xdb\x2f\x45\x76\xd4\xb5\x26\x21\xb6\x07\x90\x1d\xa9\xb4\xc9\x6a\x6c\x2f\x45\xf6\x45\xf8\x0a\x1a\xb6\xbf\x5b\x0f\x41\x0a\x75\xb9\xc9\xe3\x53\xc8\xfe\x06\xb2\x57\xe3\xfc\x63\xfb\xab\xc8\x7e\x09\xce\x3f\xb6\xff\x02\xd9\x2f\xc5\xf9\xc7\xf6\x9f\x20\xfb\x65\x38\xff\xd8\xfe\x7d\x64\xbf\x1c\xe7\x1f\xdb\xbf\x85\xec\x57\xe0\xfc\x63\xfb\x33\xc8\xbe\x18\xe7\x1f\xdb\x9f\x42\xf6\x2b\x71\xfe\xb1\xfd\x5f\x90\x7d\x09\xce\x3f\xb6\xff\x13\xb2\x5f\x85\xf3\x8f\xed\x9f\x43\xf6\xab\x71\xfe\xb1\xfd\xef\x90\x7d\x29\xce\x3f\xb6\x7f\x1a\xd9\xaf\xc1\xf9\xc7\xf6\xbf\x40\xf6\x6b\x71\xfe\xb1\xfd\x93\xc8\xfe\x01\x9c\x7f\x6c\xbf\x07\xd9\xaf\xc3\xf9\x3f\x8d\xec\x06\xb2\x43\x9c\x7f\x6c\xbf\x0b\xd9\x3f\x88\xf3\x8f\xed\x35\xab\x20\x48\x14\xae\x77\x6e\x13\x26\x5a\x1f\xa8\x87\x84\x7f\xe1\xbb\x53\xb6\x5d\xa4\x9e\x23\xbc\x02\x13\xad\xff\x58\x0f\x91\xb7\x8a\xff\x81\xbc\x6d\x38\x25\xe4\x5e\xb6\xae\x12\xc6\x2b\x9e\xb9\x9e\x34\xc9\x8b\xef\xb4\x5f\x28\x06\xba\xf3\x59\xd4\x0a\x8b\x76\x61\xa2\x55\x41\xe1\x26\xd6\x0e\x62\x31\xc1\x29\x21\xf7\xb2\x70\x70\xaa\x4c\xc8\x1d\x17\x0e\xe6\x6f\x14\x4a\x0e\x09\xdf\x9b\xb2\x96\x08\xe3\x15\x9f\xa0\x02\x2f\x42\x02\xfd\xe4\xed\x6e\xbd\xaa\x1e\x02\x90\x6d\x10\xf6\xb4\xde\x76\x3d\x24\x04\x06\xd6\x45\xc2\x78\xeb\xe7\x56\x41\x90\xbf\xd6\xb6\xed\xbc\x60\xdb\xf6\xa1\x8a\x13\xab\x20\x28\x41\xc1\xc9\xfd\xf4\x67\x09\x3f\xd7\x36\x9c\xd1\x84\xcd\xf8\xc6\xce\x96\x6f\xf9\x0b\x53\xb6\xed\xe2\x5b\x5e\x70\x1a\x53\x28\x73\x7c\xcb\x3f\xba\xf2\xdc\xf8\x96\xa7\x28\x91\xae\x07\xdf\x72\xe7\x7b\x98\xbe\x6b\x6d\x10\x82\xee\xf1\xd6\x15\x41\xf8\xbe\xf2\x2d\xff\x90\xc4\xfe\xa3\x3a\xe8\xc3\xb7\xbc\xbf\x0e\xce\xc8\xb7\xfc\x04\x71\x77\xf3\x2d\xdf\x40\xe4\x26\xeb\xe0\x79\xf0\x2d\x6f\x78\x0f\x33\x31\x7e\x74\x31\xc7\xb7\xfc\xd6\xef\x30\x36\xb2\x98\xe3\x5b\x7e\x11\x93\x41\x9e\x2f\xdf\xf2\x67\x89\xe4\x75\x8b\x39\xbe\xe5\xfb\x09\x76\xc9\x62\x8e\x6f\xd9\x24\xd8\x7b\x8b\x39\xbe\x65\x91\x60\xa7\xaf\xe0\xf8\x96\x6f\xc1\xd8\x1c\xdf\xf2\x1c\xdf\xf2\x4c\x7c\xcb\x32\x26\x81\xba\x30\x7c\xcb\xa3\xef\xe0\xf6\xe3\xcb\xb7\x7c\xc9\x72\xc8\xf1\x2d\x7f\xf6\x72\x37\xdf\xf2\x37\xdf\xc6\x99\xff\x13\xe1\x5b\x7e\x8d\x28\xb0\xf7\x96\x41\x8e\x6f\x19\xcd\x1f\xb8\x12\xf9\xca\xc9\x62\xb5\xcc\x8a\x6f\xf9\x21\x22\xf4\x7e\x2c\x74\xed\x6e\x6c\xf8\xf0\x2d\xdf\x82\x1c\xcf\x9a\x6f\x79\x2d\xee\xbc\x98\x6f\xb9\x05\x09\xe0\x74\xee\x3f\xbd\x4d\x94\xfe\xcc\x7c\xcb\xff\xb7\x06\x3a\xf8\x96\xcb\xdf\xb6\xed\x73\xe0\x5b\xbe\xf6\xa4\x6d\x9f\x1b\xdf\xb2\x79\xd2\xb6\xcf\x81\x6f\xf9\x0e\xd2\x43\x66\xc1\xb7\xfc\x2f\x27\x91\x97\xdf\x33\xdf\xf2\xb5\x9c\xf4\x59\xf3\x2d\x3f\xfc\xdb\x42\x1e\xce\xc0\xb7\xfc\x1b\xec\x65\xb6\x7c\xcb\x6d\x5e\x72\x3d\xf9\x96\x1f\xe2\xe5\xfe\x9e\xf9\x96\xdf\x7d\x93\x30\xf2\x79\xf0\x2d\x3f\xff\x66\x31\x7e\xca\xb7\xfc\xe3\x45\x78\x4e\x19\xaa\x66\x7c\xcb\x4b\xdf\xa4\xf9\x6d\x3d\x58\x05\x40\x5e\xc5\x79\xac\xe8\x81\x68\xce\x79\x45\x6f\xd5\x74\xbe\xe5\xc0\x9b\xb6\x4d\x68\x1e\xe1\x9b\xb6\x9d\x5f\xfa\x5b\xdb\xbe\xc0\x7c\xcb\xa9\xdf\xda\xb6\x1f\xdf\x72\x37\x73\xa3\x7c\xcb\x91\x45\x00\xe4\x7f\xf5\x06\xd6\xc8\x67\xc7\xb7\x7c\xe9\x6f\x6d\xfb\x4f\x98\x6f\xb9\xff\x04\xa9\x77\xca\xb7\x5c\xfe\x26\x1d\xd4\x7c\xf8\x96\xff\xe1\x04\x6e\xfb\x33\xf0\x2d\x7f\xfa\x04\xae\x19\x9e\x6f\xf9\xef\xdf\xc0\x90\x1f\xdf\xf2\xee\x37\x68\x5d\x52\xbe\xe5\xe3\xc7\x6d\xdb\x9b\x6f\xf9\x56\xe6\xd3\xcd\xb7\xdc\xca\x1c\x5c\x7c\xcb\xdf\x38\x8e\x2a\x66\x16\x7c\xcb\xea\x71\x9c\xaf\x99\xf8\x96\xbf\x79\x82\x0c\x9f\xde\x7c\xcb\x0b\x4f\xd8\x36\xa6\x50\x6d\xb9\x18\xb3\xfd\x5e\x76\x2d\xe4\x7b\xcb\xcf\x5e\x67\xbd\xe5\x8d\xd7\x6d\x3b\xb1\x2f\xff\xd3\xe3\x78\x04\xfc\xe5\x45\x17\x84\x6f\xf9\xdb\x38\xf9\x4e\xbe\xe5\x4f\x5d\x83\xfa\x6e\x6b\xed\x42\x37\xdf\xf2\xe3\xc7\x59\x5a\x12\xaf\xb3\xf4\x4f\xe7\x5b\xbe\xfa\x75\xac\x01\x16\x5e\x03\xcf\x9b\x6f\xf9\xe9\xa5\x70\x06\xbe\xe5\x83\x24\xa2\x67\x96\xc2\x19\xf8\x96\xff\x0f\x12\x31\x4b\xbe\xe5\x08\x11\x78\xfb\x52\x78\x26\xbe\xe5\x2d\x81\x33\xf1\x2d\xd7\xbe\x8e\xd5\x25\x0c\x70\x7c\xcb\x2f\xfc\x06\x37\x84\xb3\xe3\x5b\xfe\x67\x2e\xd0\x2c\xf8\x96\x77\x15\xbd\x9f\x27\xdf\xf2\xa5\xbf\xa1\xc3\xc6\x1c\xdf\xf2\x4c\x7c\xcb\xb7\x1c\x43\xd3\x18\x7f\xbe\xe5\x7f\x3b\x56\x40\x38\xbe\xe5\x27\x10\x3a\x7e\xed\x8a\xab\x70\x57\xfb\xd1\x02\x37\xdf\x72\xd7\xaf\x71\x7d\x31\xbe\xe5\xcf\xbf\x66\xdb\xf9\x17\x71\x18\xc2\xb7\x7c\x74\x7e\x91\x6f\xf9\xab\xaf\xb1\x6e\xf9\xdd\xd7\x0a\xfd\x3c\xff\xaf\xc7\x6c\x9b\xd0\x04\xef\x9c\x4f\xf9\x96\x9f\x5c\xe2\x50\x2d\x9f\x28\x84\xfb\x1b\x2e\xdc\x47\x8f\xd9\x36\x23\x18\xfe\x6e\xe5\x9f\x10\xdf\xf2\x27\x5f\x2b\x66\x7c\x51\x25\xe3\x5b\x56\x39\x74\xc3\x3c\x17\xdf\xf2\xd3\xaf\xda\xb6\x2f\xdf\xf2\xd6\x57\x71\x8f\x9d\x05\xdf\xf2\x63\xaf\xa1\x26\x34\x2b\xbe\xe5\xd3\x93\x1e\x42\x3d\xf9\x96\xfb\x1d\x42\xff\xd0\x7c\xcb\x0f\x4e\x16\xf3\xc6\xf1\x2d\xdf\x3b\x59\x4c\x9d\x8b\x6f\xd9\xac\x00\x20\xff\x81\x57\x9d\x1b\xa2\x6d\x7f\x30\x3e\xda\x1f\xe0\x94\x9e\x1d\x1f\x6d\x35\x0e\x33\x2b\x3e\xda\x97\xf3\xa8\xb6\xa6\xf3\xd1\xfe\xfa\x57\xb6\xfd\x3e\xf0\xd1\x72\xf3\xef\xdf\x03\x1f\xed\xc3\x79\x54\x0e\x73\x7c\xb4\x7f\x14\x7c\xb4\x9f\x7d\xd9\xb6\xa7\xf1\xd1\xde\xf7\x32\xaa\xa1\x3f\x46\x3e\x5a\xfc\xfe\x8d\x7b\xe3\x76\xd6\xec\xeb\xff\x85\xb8\xd7\xc1\x1c\xff\xfa\x7f\x39\xfe\xf5\x92\xa5\x65\x1b\x3b\xe9\x7b\xe7\x03\x2f\xda\xf6\x0e\x00\xc0\xf1\x97\x6c\x1b\xaf\x98\x7e\x69\xdb\x98\x57\xf8\x65\xdb\x46\xab\xa5\xea\xd7\x6d\x7b\xa0\x04\x80\xc8\x3b\xb6\x7d\x1c\x00\xb0\xef\x5d\xdb\xae\xa5\x87\x38\xfc\xf8\x94\x6b\xe9\xfb\xfe\xed\x2f\xda\x76\x04\x2d\x7a\x17\x55\x6f\x5a\xb4\xe4\xe6\xaa\x85\xc3\x95\xbb\xc1\x8d\x57\x6f\xbc\x7e\x75\x6d\x0d\x0b\x8f\xd2\xb1\xe3\x25\xdb\xe6\xcf\xb5\xd4\x53\x2e\xd9\xed\xbf\xb4\xed\xd9\xf2\x31\xff\x25\x00\xa0\xee\x25\xdb\xf6\xe3\x63\xfe\x02\x00\x60\xfd\x29\xdb\x3e\x1b\x3e\xe6\x17\x00\x00\xf1\x77\x6c\x7b\xb6\x7c\xcc\xd5\x25\x00\x7c\xe9\xd7\xb6\x7d\xb6\x7c\xcc\x73\xcf\xdc\x33\xf7\xcc\x3d\x73\xcf\xdc\x33\xf7\xcc\x3d\x73\xcf\xdc\x33\xf7\x9c\xfd\xc3\xce\xfb\xb3\xf3\xfd\xec\x3c\x3f\x3b\x73\xfe\xed\x72\xa7\xfd\x67\x2e\xfb\x31\x6a\x67\x67\xe6\x17\xd1\xc3\xe7\xec\xcc\x37\xbb\x47\xc7\xce\xda\xb3\xfb\x73\xec\x8c\x34\xbb\x7f\xc0\xce\x40\xb3\x33\xf6\xec\xac\x34\xbb\x6f\xc0\xce\x42\xb3\x35\xcf\x5b\x53\xb6\x0e\xb8\xfb\xd8\x85\x33\xef\x74\xc5\xc0\xce\xba\x33\xfe\x5a\xb6\xd0\xfb\x38\xf5\xc8\xce\xb0\xb3\x33\xdc\x85\x3b\x05\x6d\xc5\xfb\xe0\xe8\x89\x50\x87\xcb\x9d\xe2\x0b\x67\xc2\xdb\xaa\x9c\xf8\x63\x17\x3b\xd3\xf9\x30\x4d\xdf\x02\x57\x7c\x53\x36\x49\x7f\x9e\x16\x94\x4d\xed\x2c\x1d\xc7\xa9\x7d\x37\x75\x7f\x87\xda\xcf\xe6\xee\xf7\x1f\xf2\x61\xf7\xe6\xdd\xcf\xe7\x69\x7b\xf8\x32\x35\x0f\x51\xf3\x87\xd4\x7c\x85\x9a\x27\xa9\x39\x8f\x1e\xfa\x5f\x4c\xcd\x15\xd4\x6c\xa6\xe6\x26\x6a\xde\x4a\xcd\x04\x35\x87\xa8\x79\x1f\x35\x1f\xa2\xe6\xe7\xa9\xf9\x65\x6a\x1e\xa2\xe6\x0f\xa9\xf9\x0a\x35\x4f\x52\x73\x1e\xad\xf8\xc5\xd4\x5c\x41\xcd\x66\x6a\x6e\xa2\xe6\xad\xd4\x4c\x50\x73\x88\x9a\xf7\x51\xf3\x21\x6a\x7e\x9e\x9a\x5f\xf6\xe1\x43\x62\x4f\x5b\xfb\xcc\x54\x0f\xee\xef\x3f\xbd\x12\xf6\xf6\xf7\xa6\x0f\x3e\xcb\xcf\x3f\xcd\xf8\x94\x81\x92\x02\xcf\x81\x13\x2f\x2d\xf4\x4b\x27\x5e\x56\xe8\x8f\x4e\xbc\xdc\xeb\x93\x3f\xf8\xfb\x49\xbb\x2f\xf2\xc2\xe7\x15\xfa\x8d\x13\x9f\x5f\xe8\x7f\x4e\xbc\xb2\xd0\x4f\x9d\xf8\xf4\x7b\xbe\x04\x0f\x80\x7d\x9e\xf8\x42\x70\xc0\xa3\xde\xca\xc0\x45\x05\xfd\xe9\xc4\xa7\xdf\x17\x26\xf8\x22\xe7\x65\x99\x02\x5e\x55\xe0\x7f\x70\xe2\xd5\x60\x89\xfb\x43\x46\xf4\x7b\x4b\x8f\x79\xf4\xb3\x32\x70\x69\x41\x6f\x3a\xf1\xcb\x3c\xfb\x65\x19\xb8\xbc\x70\xef\xca\x89\x5f\x51\xd0\x87\x4e\x7c\xb1\x67\xfb\x2c\xf3\xb8\x4f\x4d\xf0\x25\xd3\x30\xbf\xef\x69\x91\xef\x57\x15\xcb\xd3\xf9\xfd\xaa\xc5\x60\x07\x95\xcf\xf8\x43\xea\x7c\xe4\xac\xa6\x72\x8e\xbb\xea\x65\x2d\xc5\x23\xae\x4f\x4f\x09\x14\xdf\xed\xc2\x3f\x82\xe3\x2d\xe6\x8b\x35\xeb\x34\xfe\x3d\xbd\x9c\x3f\x43\xe5\x1c\x70\xc9\x79\x94\x7e\x7f\xcb\x5d\x5f\x7e\xe9\x7f\x1a\xfb\xaf\x06\x82\x9b\xc8\xcc\xc7\xff\xb7\xf1\xdf\xe9\xed\xe7\x05\x2c\x67\x7a\x3d\x9e\xa4\xe5\xe9\xae\xaf\xd2\x12\x82\xbb\xeb\xb7\x0a\xe3\xd3\xdb\xcf\x12\x1f\x1e\x86\x55\x3e\xfc\x06\x1f\xf5\xc1\xff\x97\x8f\x9c\x17\x7d\xf0\x12\x1f\xbe\x82\x6b\x7c\xf8\x13\x52\xa5\xde\xbc\x16\xc3\x3e\x72\xfc\xf8\x2b\x1e\xf1\xc1\x4f\x94\x7a\xf3\x03\xbc\x5d\xea\xcd\x3f\xb0\xd0\x87\x57\xe1\xba\x32\xef\x74\x6e\x2c\xf3\xce\xd7\xb8\x8f\x9c\xbf\xf7\x91\xf3\x55\x1f\xff\xdf\x29\xf3\xe6\xf1\x78\xd5\xc7\x7f\x9b\x0f\xcf\xc3\x80\x0f\x9e\x2e\xf7\xe6\x49\xf8\x9c\x8f\x7f\x3f\xbe\x8b\x6b\x7d\xbe\xd3\xd3\x54\xe1\xed\x7f\xb3\x0f\xfe\xb7\x3e\xf8\x57\x2a\xbc\x79\x21\x9e\xf5\xf1\xff\x7c\x85\x37\x7f\x85\xed\xe3\xff\xba\x79\xde\x78\x74\x9e\x37\xdf\x45\xef\x3c\xef\x7a\x7f\x60\x9e\x37\x0f\xc6\xd2\xf9\xde\xfe\x1b\xe6\x7b\xc7\xdb\x31\xdf\x9b\x37\x23\xe2\xe3\xff\x66\x1f\xfc\x4e\x1f\xdc\xf4\xc1\x3f\xe5\x83\xff\x83\x0f\xfe\x15\x1f\x5c\xf2\xc1\xbf\x33\xdf\x9b\xc7\x63\xb6\xdf\x73\x8a\x19\x96\x69\x65\x13\x89\x70\x0c\x14\x99\x3a\x44\x2b\x2d\xc6\x54\x5d\x93\x4d\x20\x8a\x71\x5d\x4c\xaa\xfa\xa0\xa4\x8a\x71\x4b\x37\x4c\x51\xca\x8e\x80\x98\x9e\xce\xa8\xb2\x25\xc7\xc3\xeb\x9a\x37\x34\x7b\x7b\xc2\x1c\x20\x84\xca\x55\x94\x35\xcb\x18\x05\x98\xc1\x54\x8c\x67\xd3\xe9\x51\x20\x8a\x9c\x4d\x2c\xb2\xbe\x52\xaf\xa2\xb8\x69\x6b\x7b\x4f\x97\xd8\xd5\xdb\x29\x8a\x40\x14\x37\xf7\x6e\x13\xbb\x04\x0a\x0a\x9d\x5b\x7d\xbe\xf3\x24\x8a\x03\x3d\x1d\x85\x40\x71\x53\x17\x53\x92\x16\x57\x65\x20\x76\xde\xde\xdb\xde\x13\xed\x20\x2f\x35\xdb\xda\xf8\xcb\xf9\xe7\x49\x02\x42\x85\x15\x2f\xed\xcf\x8a\x7a\xc4\x11\x6a\x16\xc4\x10\xae\x58\x22\x1e\x9c\x27\x2e\x91\x3c\x7f\x46\x31\xc3\x24\xb6\x73\xa6\xfc\x98\x9e\x59\x17\xfd\x0b\xe6\xf0\x70\xa6\x44\x1e\x51\x2c\x77\x89\x9f\x37\xa5\xc6\xf4\x84\x10\xca\x19\x5f\xb6\x18\x57\x0a\x66\xa2\xa6\x39\x3f\x3e\x96\x69\x29\x23\x4c\x13\x1e\xf1\x7b\xc6\x4e\x5f\xdf\xbb\xbc\x3b\x38\x71\x2e\x50\x61\xf2\xb4\x27\x5e\x89\xb6\x74\xd5\x19\x13\x47\x9f\xe1\x91\x84\xb3\x27\x1b\x71\x32\xd1\x4c\xef\x11\x17\x8c\x5a\xc6\x99\xbb\x0d\xde\x8c\x29\x94\xf6\xc4\x23\x67\xe7\x49\xf0\xe3\xee\x97\x4e\x7e\x19\x77\x45\x73\x8c\x22\x2e\xa2\x97\xa2\xcf\x35\x67\x24\x42\x99\x4e\x8f\xe3\x51\xbc\x3e\x34\x33\x2e\x9f\xb2\x16\x3f\x2b\x8a\x21\x77\x44\x7f\x74\x24\x32\x84\x60\xc8\x59\xee\x84\x06\xc6\x55\x17\x94\xe4\xe5\xc3\xda\xb0\x53\xdd\x14\x0e\xbd\x78\xf5\x82\x22\x47\x91\x33\x0c\xa1\x00\x2a\x86\x68\x5c\x43\xce\x70\x38\x65\x14\x28\x6b\x5c\xa2\x31\xab\xd6\x4c\x1c\xec\x98\x35\x3c\xa9\x65\xc3\x98\x7c\x34\xa4\xc4\x01\xb6\xa5\x24\x33\x05\xc2\xf1\x51\xcd\x1c\x4d\x13\xd3\x32\x88\xcb\x90\x6c\x98\x8a\xae\x39\x2c\xa2\xc1\x68\xc9\x47\x35\xfa\x2b\xa3\x5a\x00\x93\xb2\x03\xf4\x33\x9c\xd4\x2d\x40\xa9\xde\xb1\xbe\xa5\xb4\xee\xa0\x40\x50\x2e\xa6\xe2\x06\xe0\xe8\xca\x79\x3e\xf7\xe2\x84\x00\x27\x45\x4a\x2b\x31\x80\x24\x92\x48\x38\xea\x78\xc6\x85\x7e\x01\x9e\xa5\x74\xcf\x92\x6d\xc7\xf8\xf1\x56\xb2\xa7\xdc\x65\x0f\xa3\x35\xa4\x6d\xeb\x2c\xbc\x9b\x1f\x93\xf1\x1c\xb3\xfd\x4c\x37\x4f\xf0\xfa\xc2\x5a\x9f\xda\x4b\x9d\xe6\x11\xee\xfb\x5c\x25\x1e\x3c\xbf\x6d\x54\x76\x61\x3b\x69\xbe\xd3\xfc\xf8\x19\x78\x7e\x05\xba\xe7\xca\xc2\xb3\x7d\x26\x66\x26\x5c\xe9\x2f\x75\x99\xdb\xe8\x1e\x2e\xb3\xb3\xfd\x28\x66\x1e\x00\xc5\xf4\x97\x79\xe4\x3f\x4e\xdd\x58\x78\xb6\x6f\xc5\x4c\xb6\x6f\xec\x2e\x3f\x96\x7f\x8d\xfb\x66\x05\xe0\xf6\xa1\x99\xc9\xf6\xad\xcb\x7c\x78\x96\x47\x5c\x3c\xe7\x6c\x9e\xcc\xcc\x2b\x5d\xe9\x75\xd7\xbf\xe9\x0a\xcf\xf6\xcb\x98\x19\xf1\xe1\xe9\x66\xe6\xbd\xae\xf0\x6c\x5f\x8d\x99\x67\xe2\x39\xbf\xdf\x15\x9e\xed\x17\x31\xf3\xda\xab\xbc\xe3\x67\xcf\x84\x2b\x3c\x5b\xb7\x33\xd3\xfd\x65\x75\x77\xfe\xff\xda\xcd\x33\x1f\x76\x9a\x47\x4b\xbd\xd3\xcf\xda\xe1\x67\x5d\x5c\xd7\x7e\xfc\xaf\x7e\xf1\xff\xa3\x2b\x3c\xdb\x1f\x64\xe6\x1a\x1f\x9e\x7b\xf6\xfc\xab\x8b\x67\xbe\xc8\x07\x4b\xec\xee\xf2\x72\x97\xff\xd3\x94\xbf\x87\x85\x67\xfb\x60\x4f\xb6\x7a\xc7\xe7\x0e\xff\x6f\x2e\x2e\xa7\x22\xdf\x2e\xb1\x1f\x2f\x71\xe6\x9b\x85\x63\xe9\xfa\xa4\xeb\x3b\x05\x6c\xff\xf2\xf1\x1b\x89\xbd\xef\x0c\xf1\xff\xc0\xfd\x9d\x03\xb6\x3f\x46\x37\x16\xeb\x7c\xca\x8f\x99\xff\xe9\x0a\xcf\xf6\xcb\xda\xc8\x87\xe4\xa7\x7d\xb7\xdd\xbd\x17\xf3\x92\xeb\x3b\x09\x6c\xfd\xfd\x68\xbb\x33\x1e\xf6\xb8\xf5\x57\x1e\xf8\xf0\xc4\xd3\xf0\x6e\xfd\xed\xe6\x89\xf7\xe3\x59\x3f\x42\xc3\x1f\xa5\x09\xb8\xfa\x2c\x79\xd6\x1f\xbe\x99\x98\x3f\x98\x3f\x73\xfa\xfd\x78\xd6\xd5\xad\xc4\x3c\xe1\x93\xfe\xb9\xe7\xff\x8d\xc7\x9f\xff\x7d\x50\xd1\xe2\x8a\x96\x0c\xf7\x48\x3b\xe5\x84\xa2\x9e\x15\xe3\xbb\xf3\x89\x44\x22\xcd\xcd\x6b\xfc\xf8\xdf\x23\x91\xc6\xe6\x26\x17\xff\x7b\xe3\xba\xe6\xd5\x73\xfc\xef\xef\xc7\xb3\x0c\x0e\xa4\x14\x13\xa2\x0a\x86\x8a\x09\x93\xb2\x86\x16\x1d\x72\x1c\x0e\x8e\xc2\xe4\x68\x66\x23\x8c\xeb\xf8\x8c\xba\x1c\x57\xac\x70\x20\x20\x8f\x64\x74\xc3\x82\xb8\x85\xc4\x15\x43\x44\x6b\x69\x78\x63\x2b\x0c\xd3\x46\x13\x0e\x84\xfb\x84\x2d\xbd\xb7\xb7\x40\x49\x55\x03\x92\xaa\xb6\x04\x16\xd4\xd6\xf5\xb4\xdf\xd2\x15\x84\xa4\x75\x05\xfe\xd0\x39\x9e\x7b\xf8\xc7\xbf\xff\xc7\x74\x2d\xa1\x24\xc3\xc9\xd1\x8c\x72\x9e\x71\x9c\xa1\xff\xaf\x5b\x37\xbd\xff\x47\x9a\x9b\xe7\xfa\xff\xfb\xf1\x2c\x83\x9d\x5c\x07\x87\x9b\x90\x1e\x18\x96\x5c\x8a\x00\x35\x92\x50\x72\x34\xb3\xd2\x84\x35\xa4\x59\x64\x0d\xb9\x06\x9a\x96\x9c\x09\x7c\x34\x00\x61\x0d\xf9\x3e\x88\x18\x97\x13\x52\x56\xb5\xcc\x9a\x16\x88\x60\x08\x6b\x62\xf8\x3a\x47\x4d\x0b\xfc\xf3\x3b\xeb\x09\x42\xfd\x88\x4c\x0e\xde\x44\xaa\x69\x81\x35\xf4\x7b\x23\x35\x45\x7f\x8a\x26\x3b\x82\x2a\x5a\x4c\xcd\xc6\x65\x31\xae\x18\x0e\x5c\x55\x06\x0d\xc9\x50\xa8\xe7\x00\x84\x1f\x43\x0e\x35\x43\x92\xa1\x48\x83\xaa\xcc\x25\x47\x32\x25\x14\x57\x84\x06\x8c\xe9\x43\xb2\x21\x25\x65\x14\x7d\x42\x52\xf9\xc8\x07\xb3\x49\x31\x2e\x0f\x59\xba\xae\xa2\xf0\x35\xa8\x08\x9c\xae\x29\xcb\xca\x34\xf9\x84\xd4\x92\xde\xae\x09\xdd\x88\xc9\x22\xdd\x3b\x10\x63\x86\xc5\x25\x26\xa5\x9b\x96\x28\x19\xb1\x14\x0a\x35\xd2\xbc\x86\x85\x51\x62\x59\x31\x39\x9a\x11\x33\x92\x85\x9d\x70\x92\x1a\x94\x58\x16\xfd\x1f\x32\x47\x4d\x4b\x4e\xa3\x5e\xca\xfb\x37\xd3\x92\xaa\x4e\x8b\x5d\x55\x87\xd2\x22\xdd\x2a\xe1\x22\xc6\x2a\x60\x70\xd4\x92\x75\x23\x2e\x1b\x28\x94\xaa\x58\x96\x5a\x08\x86\xdd\x65\x0d\x15\xa5\x18\x5f\x3f\x4d\x2a\xef\x3c\xb4\x5e\x1c\xb2\xb2\x9a\x7c\x97\x62\x79\xfb\x53\x34\xd3\x92\x54\x55\xd4\x32\x69\x6f\x0f\x74\x57\xb3\x98\xca\xb5\xeb\x78\x67\x4d\x17\x07\x0d\x7d\xd8\x94\x0d\xfa\xbe\xc6\xf4\x16\x93\x31\xe4\x84\x32\x82\xdc\x1a\xb2\xa6\xe1\x70\x32\x48\x3b\x13\xb3\x86\x3a\x88\xda\x5b\x0b\xac\x71\xb8\x9b\x29\xc9\x90\xe3\xde\x62\x89\x9b\x18\x93\x0c\xdc\xaa\x6a\x2c\x23\xeb\xe9\x01\xd5\xbe\x98\x91\x0c\x93\x94\xa7\x9f\x37\x55\x19\xcc\x0e\xcd\xe4\x81\x6b\x47\x7e\x5e\xf4\x8c\xac\x99\xa6\x3a\x93\x97\x7b\x54\x65\xd0\xd3\xdd\x92\x92\xd3\xb2\x9f\x35\x65\x71\x30\xab\xc5\x55\x39\x2e\x0e\xad\xf7\x0c\x86\xbc\xc4\x2d\x43\x8a\x4d\xef\x38\x05\x77\xd9\x1a\xf6\x77\x54\x2d\x4b\x4b\xfa\x3b\xcf\x94\x25\xe4\x9e\x91\x8d\x44\xcc\x32\xfc\x05\x0c\xad\x17\x33\xaa\x64\x25\x74\x23\xed\x29\x64\x58\xb1\x52\x7a\xd6\x12\xb1\x45\xcf\x20\x0d\x34\xbd\x1d\xd1\x54\x88\x09\x25\x63\xf2\xa5\xc4\x70\x4d\x17\x25\x33\xcd\xf5\x23\x33\xa5\x2a\x83\xa2\x99\x4d\xd0\x96\x67\xea\xe1\xb5\xeb\x58\x28\xaa\x21\x3d\xfa\x77\x76\x08\xb5\x14\x59\xb3\x0a\x3d\xbc\x21\x2e\x67\xcc\x86\xec\x50\x03\xe7\x65\x86\x32\x1f\x5a\xcf\xba\x5f\x32\x3e\x48\x7a\x5e\x64\x9a\x93\xd2\xb8\x5e\x13\xcd\x6c\x06\x4d\xdf\x6a\x5a\x60\xe3\x74\x0f\x9a\x99\xc1\xbb\xdc\x4e\x57\x4d\xc7\x5b\xdc\x31\x4b\x94\x54\x45\x32\x15\x5c\x6f\x9c\x3b\x2a\xbd\xb4\x72\x8f\x1c\x17\xb1\xe6\x73\xc6\x9d\x31\xf4\xb4\x62\x22\xd9\x96\x6c\x68\x12\x2a\x4b\x59\x8d\x8b\x31\x3d\xab\xb9\x12\x61\x48\x5a\x5c\x4f\x8b\xa6\x8c\x7b\x1e\x27\x02\x67\x59\x4c\x4b\xb8\x0e\x38\x1c\x95\x87\xa9\x49\x19\x33\xa5\x4f\x57\x35\xc3\x92\x66\x89\xa6\x9c\x91\xd0\x08\x26\x62\xc5\x8a\xb5\xa6\x6c\xb9\xd4\x5e\x5c\x31\x98\x8e\x68\xa0\xa3\x4b\x03\xc2\xef\x32\x99\x28\xd3\x92\xb4\xb8\xa4\xea\x9a\x2c\x9a\x96\x64\x29\x31\x91\x8c\x36\xa3\x5c\xfa\x63\x52\x2c\x25\x8b\xaa\x1e\xdb\x89\xfc\xa8\xb8\x86\x9a\xd1\x2c\xa0\xa0\x78\xe5\xa4\x14\x1b\x25\xdd\x8a\x94\x60\xa1\x35\x99\x4a\x52\x13\x93\x8a\xe5\xee\x8b\x59\xa4\xe2\xa4\xa4\x8c\x0b\xaa\x46\xcb\xa4\x1b\x56\x87\xd7\x86\x9b\xf0\x50\xdc\x30\xb4\x3e\xdc\x18\x09\x47\xa0\xaa\x68\xd9\x11\xc8\xb5\x25\x49\x1d\x96\x46\x4d\x51\xca\x92\x86\xc4\xe0\x41\x45\x13\x55\x45\xdb\x39\x4d\x67\xed\x94\x47\x79\x7f\x71\xd9\x8c\x19\x4a\x86\x8d\xc8\xbc\xcf\x84\x6c\xc5\x52\xa2\x21\x5b\x74\x9c\xad\x69\x62\x2e\x29\x59\x8a\xd3\x4c\x21\xad\xce\x46\xa1\x04\xd2\xc1\x26\x4d\x7f\x01\xd5\x14\x8b\xd3\xed\x35\x8d\xe1\x48\x38\xc2\x67\x99\xf7\x8c\x07\x4b\x47\xcf\xd3\x54\x47\x72\x49\xb9\xa7\x15\x22\x2a\xe2\x88\x44\x55\x62\xb2\x46\xb4\x7b\xb4\xbf\x83\x39\xa1\x49\x0e\x6e\xe1\x35\x43\x0a\xc3\x0c\x5d\x55\x07\xa5\xd8\x4e\x77\x8e\x2d\x29\xc9\x92\xca\x0d\x27\x43\xae\xc8\x25\x8c\x46\xb5\x04\x8a\x75\x94\xcf\x0a\x99\xe0\xe0\xe6\x95\xd2\xd3\x72\x03\x99\x40\x36\x84\xb5\x4c\xda\x88\x15\x12\xa4\x25\x15\xdc\xb4\x50\x17\x9b\x56\x52\xa8\x1e\x75\xb2\xc8\xf2\x73\xcb\x1a\x2a\xef\x64\xa5\x33\x38\x46\x64\x16\x2a\x35\x43\x5a\x83\x3b\x91\xa6\x34\x24\xa3\x39\x8e\xb3\xd9\xd1\xe9\x10\x03\x32\x86\x9e\x34\x64\x73\x5a\xcb\x41\xe3\x92\x89\x3a\xf8\xc8\xa8\xab\x8a\x74\x29\x2e\x92\x66\xc4\x3b\x18\x32\x5e\x55\xd0\xa1\xc5\x2d\x0d\x27\xa5\xe8\x54\x80\x53\x32\x99\xc6\x34\x0c\x2a\x5a\xc3\xa0\x64\xa6\x8a\xc9\xe2\xc7\xf7\x06\xfc\xd2\xaa\x90\x61\x63\x54\xfc\xff\xd8\x7b\xfa\xef\xb4\x75\x25\x7f\xc6\x7f\xc5\x5c\xb7\xaf\x35\xb7\xc4\x40\x92\x26\xb9\x49\xc8\xb9\x29\x90\x96\x7d\x29\xf4\x84\xa4\xbd\xf7\xb4\x5d\x8e\xb0\x05\xa8\x31\x12\x4f\x92\x43\xb2\xf7\xe4\x7f\xdf\xa3\x0f\x1b\xdb\x40\x3e\x76\xbb\x7d\xdd\xf7\xa2\x9e\xd3\x60\x7d\xce\x8c\x66\x46\x33\xb2\x46\xe6\x31\xcd\x09\x80\xb1\x19\x96\x99\x47\x0b\xed\x1c\x19\x73\xa5\x9e\x95\x59\xf3\x5e\x55\xcb\xb9\x41\x76\xbf\x5a\x4d\xf2\xd4\x1c\x7e\x13\x3e\xe3\xe3\x6a\x0e\x03\xb3\x94\xa0\xdc\x8c\x88\x80\xcd\xf2\x48\x61\xb5\x08\xb0\x99\xcc\xad\x2a\x96\xd3\xc4\x12\x88\x2b\x19\x28\xa9\x61\xcf\xac\x08\x79\x93\x27\x1c\x19\x53\xc6\xb1\x9d\x06\xb1\x3c\xba\x30\xab\x80\xab\x39\x2b\x0f\x41\xb6\xb2\x26\xeb\x00\x85\x61\xc2\x01\x69\x5d\x82\xe7\x86\x9c\x53\x44\x53\x70\x59\xc4\x96\xcc\x9d\x85\xde\xb8\x51\x92\x2a\xc9\x14\xb3\x78\x99\xda\xf1\x14\x09\x2d\x81\xb5\x5a\x6d\x73\x65\x5b\x74\x9d\x69\x9b\xd3\xae\x53\x2c\x12\xae\xfd\x9b\x58\xd0\x2e\x47\x49\xcc\x73\xdc\x68\xe8\x96\x43\x95\xd0\xcb\x1c\x9d\xd0\xd5\x12\x97\xa2\x20\x28\xd0\x01\x45\x82\xe5\xc4\x87\x92\x40\x39\x0a\x85\x86\x11\xcb\x2b\xfd\x19\x67\x61\x1c\xc8\x02\xb5\x63\x2a\xd0\x48\x5b\x37\xab\xad\x96\xcc\x04\x19\xfd\x9f\xd1\x54\xda\x6a\x47\x12\x0b\x99\xa2\x68\xd6\x94\x6c\xab\x9c\xc4\x4d\x38\xa1\x97\x73\x8e\x66\x77\xcd\xd8\x08\x59\x8b\x20\xa3\x5e\xe9\x0c\xc9\x02\x32\x33\x4e\xb0\x44\xfc\x66\x80\xa4\xe4\x64\xb8\xa4\x2c\xb4\x70\xe0\x6b\x94\x57\x71\xd6\xae\x58\x61\xe9\x99\xe9\xc9\x28\x50\x2c\x83\x6a\x4e\x6f\x16\xd4\x96\xd6\x88\xc6\x75\x58\x29\x2f\x1b\xaa\x82\xbf\x58\xd5\xb5\x69\xae\xcc\x9e\x65\xa6\x30\xa2\x33\x22\xb6\x27\x3b\xb2\xc9\x5d\xa1\x3b\x32\x2b\xe2\x82\x44\x1a\xdf\x85\x8a\xfa\xcf\x74\x04\xce\xe2\x59\xc2\xfb\xab\xb4\x39\x9e\x22\x12\x2d\x8b\x2b\xbe\xd6\xa6\x49\x0e\x58\xa3\xb2\xd4\x9f\xd4\x2e\x5d\x28\x9f\x2c\x31\xbf\x09\xcb\x66\xca\x31\x76\x6e\x0b\xfb\x6f\xeb\xf7\x7f\xec\x01\x3c\x63\xb8\xfa\xd3\xcb\xff\xf9\x1e\xc3\x3d\xfb\x3f\x3b\x5b\xdb\xbb\x85\xfd\x9f\xcd\x5a\xfd\x69\xff\xe7\x87\xa4\x47\xee\xff\x9e\xf7\x7a\xa7\xfd\xf6\x39\xec\x37\xc0\x30\x86\x73\x7e\x7c\xf6\xd6\x64\xd8\xfd\xdd\x56\xfb\xa4\x3f\x68\x29\x67\x40\x65\x7e\x71\x4a\x2f\x37\x5a\xdd\x5e\xab\x3d\x78\xfb\xe7\x87\xc1\xfb\x5e\xeb\xe2\xb4\x3d\xe8\x1e\xbf\x6f\x37\x4c\xfd\x97\xb6\xca\x45\xbf\xd3\x7d\x3b\xb8\xf8\x38\xe8\xbf\x3b\x3e\x6b\xb7\x1a\xf5\x7c\xc1\xc7\xbd\xa5\x82\x8f\x7b\x83\x56\xfb\xc3\x59\xbb\x79\x7c\xde\xe9\x75\x07\x9f\x8e\xcf\xba\x9d\xee\xdb\xfe\xa2\xc2\xe0\x54\xc1\x76\xd2\x39\x6d\x0f\xfa\xbd\x8b\xb3\x66\x3b\x2d\xd0\x79\xf6\xe8\xe5\x9b\xce\x79\xbf\xb1\xb3\x9d\x94\xbd\xb9\xe8\x9c\xb6\xd4\x88\x1a\xe6\xf6\x1f\xe7\xed\x6e\xbf\xd3\xeb\x26\xc5\xad\xf6\x9b\x8b\xb7\x69\x3f\xb9\xa7\x8f\x7b\x83\x76\x57\x9f\xe3\x6c\xbe\x6b\x37\xff\xde\x7f\xe9\x38\xcf\xe0\x44\x5f\x67\x32\x43\x42\xe0\x10\x24\x03\x14\x45\x20\x58\xcc\x03\xac\x69\x2e\x7c\xa7\x79\x72\x7a\xfc\x36\x4f\xb1\x8d\xd1\x87\x4e\x53\xff\xb0\xdf\x6f\xd2\xbf\x3f\xa9\xb6\xfa\x07\xbe\x96\x1c\x99\x9f\x94\x6d\xc4\x34\x16\x38\xdc\x50\xde\xce\x14\x4b\xcc\x75\xc1\x74\x67\x5b\xff\x1d\xeb\xff\x7b\xb5\x55\xb0\x28\x7b\x1a\x9a\x05\x38\x9a\x29\x24\xeb\x9b\xbc\x7a\x55\x6c\xd4\x2c\xc0\x4f\xd9\x06\x97\x92\xa4\x0f\xf8\x3a\xc0\xc6\xcf\xd6\x59\x42\x86\x8d\x31\x8d\x5f\xbd\xaa\x5d\x3b\x4e\xa7\xdb\x2c\xa0\xdf\x59\xe1\x90\xe5\x1e\xd7\xd7\x12\x3c\x58\x5f\x98\xf8\xd5\x36\xfb\x9e\x8a\x57\x7b\x49\xb6\x63\x98\xda\xee\x50\xfe\x4b\xb1\xf5\xa3\x78\x34\x4f\x80\xef\xc8\xa5\xbd\xad\x94\x53\xd8\x94\xc8\x0d\x7d\xf8\x6a\x63\xc6\xf4\xc6\xc1\x23\x58\x77\x01\xe0\xe3\x98\xb7\x88\xd8\xa3\xd9\xb7\xd0\xc1\xcf\xc7\xc0\xbd\x37\xff\xd1\xb7\xd0\x3d\xf7\xd8\xf0\x5b\x39\xf9\xa6\xf7\x73\xcf\x68\xf0\x72\xb2\xe0\x33\x45\xba\xe3\x50\x53\x4c\x4e\x30\x44\x44\x48\x60\x23\x43\x34\x98\x63\x10\x33\x1c\x10\x14\x45\x37\x20\x39\x0a\x2e\x21\xc4\x33\x4c\x43\x4c\x03\x82\x05\x8c\x18\xf7\x1d\x14\x45\x03\x05\x01\xbc\x6a\xc0\x73\x4f\x0d\x5d\x56\x9d\x1a\x7a\x03\x96\x80\x22\x60\x57\x98\x73\x12\x62\x01\xd3\x58\x48\x18\x62\xbb\xa0\x6c\x68\xa7\xc3\x77\x9e\x41\x1f\x63\x70\xcf\x4d\xa6\x1e\x73\x44\x02\xf8\x68\x37\xf5\xe1\x23\x8a\x62\x2c\x5c\x20\x54\x43\xf9\xb6\x7b\x01\xef\xd1\x25\x86\x29\xa2\xb1\x6a\x6f\xc7\xdd\x87\xcc\x92\xf5\xdc\xb3\x0f\xe5\x45\xb1\x12\x5f\x0b\x98\xae\xa1\x25\xfd\xb9\xa7\xe5\xe5\xfc\xcf\x0f\xed\x72\x19\x9e\x7b\x7a\x8e\xf3\x99\xf0\xdc\xb3\xfc\x53\xac\x9c\x72\x63\xae\xa0\x30\xe2\x1f\x7f\xfc\x9f\x8c\x59\x1c\x54\x91\x51\x6f\x48\x02\x57\x16\x5e\x05\x66\xb1\x94\x84\x8e\xb5\x8c\x9b\x0b\xcf\x04\x10\x2a\x19\x58\xae\x70\x1c\xfb\x63\x41\xab\x0c\x8b\xfc\xcd\x67\xfb\xf0\xdc\x13\x3c\x08\x09\x57\x8f\x41\x00\x27\xbd\xb3\x66\x7b\xd0\xea\x0d\x9a\xef\x5b\x4e\xe9\xf7\xe7\x5e\xa0\xfa\x0e\xd9\x20\x98\x86\x95\xe0\xfa\xba\x52\xd7\x60\x9c\xf3\x1b\xf3\xda\x58\x8d\x3e\xe2\x6c\x9a\xb1\x35\x8c\xb2\xa9\x80\x64\xec\x61\x00\x14\x2b\x3c\x1c\x90\x07\x76\xfe\x28\xd4\xda\x54\xdf\xd6\x26\x95\x21\x25\xb0\x16\x16\x91\x21\xba\xf3\xec\xd9\x33\x38\x53\xbf\x94\x74\x80\x3e\x05\x6f\x99\xdd\x77\x4e\x5b\x2b\x96\xff\xac\x3e\xe5\xf6\x6d\x51\xa2\x2f\x9d\xb4\x49\x41\xe7\xdc\xd3\xa8\xf3\xa6\xaf\xd5\x62\x5e\xfa\x33\x1f\x7c\x34\x8c\x69\x3b\x37\x7c\x99\x8c\x54\x64\xe4\xb5\x3d\xd8\x51\x54\xd3\xce\x9b\xfe\x9d\x55\xd7\x89\xe5\xda\x06\x56\x7e\x0a\x93\x92\x9f\x13\xc1\x94\x1f\x6f\xfd\x99\xb2\x53\x50\x44\x6b\xba\x4e\xd4\x9d\x2e\x00\xbd\xcd\x9d\x9e\x62\xb0\x36\xad\xf9\xa3\x60\x48\x4e\x3e\x94\x73\x3d\x28\xe5\xc6\x66\x37\x86\x05\xac\xde\x54\xe5\xb1\xd4\xba\xca\xde\x2c\x38\x43\x72\xa2\x14\xd3\xca\x3e\xd6\x53\x64\x4d\xf5\xb5\xf8\xdc\x49\xa1\x80\xcd\x6e\x96\x08\xb3\x1a\xa9\x67\xd0\x9f\x30\x6e\x29\xa2\x59\x37\x95\x5f\x8d\xe7\x02\x41\xbf\x40\x2f\xd3\xfe\x81\xd0\xde\x41\x53\x35\x2d\x19\x32\x4a\x06\x2e\x8a\x22\x37\x15\x9e\xe2\x59\x93\xf5\x7d\xfd\xb3\xdd\xac\x9f\x36\xad\xf2\xff\x17\xa1\x77\xdf\x27\xdd\xed\xff\xd7\x6b\xaf\x5f\x27\xfe\xff\xf6\xeb\xed\xed\x3a\xd4\xea\xbb\xdb\xf5\xed\x27\xff\xff\x47\xa4\x6a\x55\x6b\x2e\x4e\xc6\x13\x09\x9b\xb5\xfa\x2e\xbc\x65\x6c\x1c\x61\xe8\xd0\xc0\x77\xaa\x55\xa7\x5a\x85\x53\xf3\x2e\x25\x84\x98\x86\x98\x6b\xe5\x76\x3c\x43\xc1\x04\x27\x25\x15\xf8\x68\x76\x19\x61\xd3\xaf\x81\xa7\x2a\xb8\xb6\xc8\x2d\x1f\xa8\x2e\x6e\x58\x0c\x53\x74\xa3\xb7\x12\x62\x81\x8d\x02\xd1\x9b\x0e\xc6\xb4\x56\x66\x9c\x0e\xca\x24\x88\x06\x18\xe6\x44\x4e\xf4\x38\xb6\x17\x05\x09\xfc\x69\xfb\x60\x43\x89\x08\x05\x04\x4a\x97\x99\x85\x37\xad\x08\x48\x5a\xa0\x55\x9a\x48\x39\xdb\xaf\x56\xe7\xf3\xb9\x8f\x34\xc0\x7a\xcb\xde\xbe\x1a\x12\xd5\xd3\x4e\xb3\xdd\xed\xb7\x37\x36\xfd\x9a\x6d\x74\x41\x23\x2c\x04\x70\xfc\x8f\x98\x70\xb3\x09\x82\x66\xb3\x88\x04\x5a\xff\x44\x68\x0e\x8c\x03\x1a\x73\x6c\x1c\x0a\x42\x61\xce\x89\xb2\xa7\x2a\x20\xd8\x48\xce\x11\xc7\xaa\x9b\x90\x08\xc9\xc9\x30\x96\x39\x9a\x25\x20\x12\x91\xab\xc0\x28\x20\x0a\xee\x71\x1f\x3a\x7d\x17\xde\x1c\xf7\x3b\xfd\x8a\xea\xe4\x53\xe7\xfc\x5d\xef\xe2\x1c\x3e\x1d\x9f\x9d\x1d\x77\xcf\x3b\xed\x3e\xf4\xce\xa0\xd9\xeb\xb6\x3a\xca\x31\xec\x43\xef\x04\x8e\xbb\x7f\xc2\xdf\x3b\xdd\x56\x05\x30\x91\x13\xcc\x01\x5f\xcf\xb8\xc2\x80\x71\x20\x8a\x9a\x38\xd4\xa4\x53\xa6\x74\x16\x04\xa5\xcc\xd5\x73\x6a\x56\x47\x88\x8e\x63\x34\xc6\x30\x56\x76\x39\x55\x3a\x7e\x86\xf9\x94\x08\xfd\x52\x02\x10\x0d\x55\x37\x11\x99\x12\x89\x8c\x1f\xb4\x84\x97\xef\x38\xcf\x12\xbf\xe4\x30\x24\x1c\x53\xe9\x4f\x8e\x32\x79\x3a\x6e\x2c\x9f\xa5\x23\x88\xf2\x59\x92\x4c\x71\x3e\x47\xa9\xa8\x7c\x8e\x30\x11\x5c\xb9\x2c\x19\x92\x42\xe7\x42\x86\x11\x19\x16\xf3\x38\xa1\xe3\x5c\xce\x8d\xa8\x0a\x16\x5c\xe2\x02\xb4\x3a\x5f\xa2\x42\x6e\x4c\x89\x90\x61\x3e\xef\x6a\xaf\xf0\xac\x5f\xa3\x1f\x39\x4e\x2c\x14\x1d\x29\x9a\x62\x31\x43\x01\x86\xab\xbd\x03\x47\x91\x31\x1f\xe0\x69\x1e\x15\x8d\xe1\xdd\xf9\xf9\x07\x7d\xdf\x72\x5a\x16\x30\xaa\x98\x5d\xf5\xe3\xae\xbe\x65\xd9\x4d\xec\x0d\x3b\x97\x38\x84\x93\x56\x22\xb9\x85\x91\x38\x96\x31\xa7\x02\x24\x8f\x31\x10\x23\x38\x27\x2d\x7d\xde\x4b\xd5\x34\x4b\xf9\x90\xb1\xa8\xd0\xd0\x23\x54\xc2\x28\x2c\xc3\x5f\x4e\xa9\x5a\x35\x3b\x86\x44\xc0\x7c\x82\x24\x74\xc7\x84\x5e\x43\xc8\x94\x43\x5d\x0a\x26\x48\x59\x09\xa3\xcf\x9b\xb5\xed\xbd\xaf\x07\x4e\x49\xcd\xe6\x40\x02\x65\x73\x68\x80\x7a\xf0\x6a\xe5\x03\xa7\x64\x6f\x40\x96\x53\x18\x4f\xa1\x01\xbf\x9a\x10\x57\xef\x05\x65\xf3\xf2\x81\xa3\x07\x39\x61\x1c\xa6\x8c\x63\x20\x74\xc4\xf8\x54\x73\x1d\xa0\x21\x8b\xa5\x06\x3b\x44\x12\x81\x29\xa8\x80\xc0\x78\x5f\x37\x4a\x5e\xd4\xe9\xb7\xfd\x3e\xc1\x72\xa4\x25\x7e\x22\xa7\x51\x95\x8f\x82\xdd\xcd\xad\xfa\x33\x81\x35\x01\x37\x76\xfd\xba\xfa\xe7\x94\xc8\x08\xbc\x24\x22\xce\x1b\xc6\xa3\x0a\x08\xf2\x5f\x98\x8d\xd4\xef\x72\x05\xdc\x75\x57\x64\xbb\x15\x78\x31\x9e\x96\xe1\xb0\x01\x35\x4d\x9a\x92\x8d\x58\xf6\x4c\x84\x5e\x45\xbf\xe2\x78\xd8\xed\xd2\x5f\xa8\x9b\x1b\x56\x11\xa9\x84\xaf\x89\xf4\xea\xea\xe7\xad\x22\x59\xb8\xbf\x9f\x8b\xe1\x4d\xb9\xe4\xc0\x29\xa5\x53\x7c\x78\x08\x6e\xe1\xea\xee\x2f\xd4\x85\xc3\x43\xa7\xe4\x9a\x1b\xbc\xd5\x83\x9a\x23\x5d\x35\x2d\x5b\xbe\xd6\x7b\xa9\xe8\x9e\x2b\xaf\xbf\xd0\xb4\xc5\x6a\x4e\xfd\x42\xdd\x03\x8b\x87\x41\x43\x21\x00\x8d\x14\x0d\x5f\x48\xee\xe9\xe9\x9f\x4f\xd4\xca\xe0\x29\x36\x35\x84\x15\x8a\x34\x03\xa9\xaa\xc6\x91\x84\x86\xe1\x4f\x6f\x14\x56\x54\x96\xaf\xc3\x30\xbd\xb2\x79\x88\x34\x06\x9e\x21\xa1\x9a\xdb\xa4\x51\x3a\x4d\x8a\x53\xfa\x5a\xe4\x35\x17\x5b\xce\xaf\xc0\x30\x96\xd0\xee\x9d\xf8\xaa\x8a\x11\x15\x2d\x29\xaa\x9f\xdb\x7c\x5f\xbf\x34\x60\xa3\x6e\x3b\x5b\x9a\xf4\x07\x5c\xe1\xed\x56\x2c\x2a\x79\x90\x2b\x4a\xc8\x0e\xcc\xf0\x96\x32\xbe\xb9\x35\xdd\xb3\x87\x4f\x02\x24\xe4\x61\x4c\x05\x19\x53\x1c\x42\xc4\xe8\xf8\xc8\xc2\x64\xf0\x5d\x86\xa6\x78\xdd\xba\xe6\xb4\x25\x3a\x25\xc8\xa5\xb9\x59\x72\x15\x89\xa1\xa9\x71\x0b\x38\x12\x5a\x8b\x78\x5a\xb1\x2b\x9a\xb4\x3b\xdd\xf3\x33\xdb\xc8\xb6\xd1\xe7\x72\x2c\x05\x6f\x9d\x5b\xc7\xb9\x62\x24\x04\x42\x89\xf4\xde\xe9\xab\x0d\x0e\x4d\xd0\xef\x91\x35\xea\x75\x6b\x7d\x47\x3a\x18\x65\x42\xe8\xe7\xaf\xd0\x00\xd7\xaf\x4e\x11\x51\x1c\xa4\x05\xbd\x19\x61\xc4\xa1\x79\xda\x6b\xff\xd1\x6e\xea\xe5\xcc\x5c\x69\xae\x56\x2a\x30\xb7\x96\xfb\x46\xb2\xef\xbd\xf0\xbc\xb1\x98\xcb\x25\xe2\x3d\xe0\xb6\x74\x4d\x4f\x4d\x81\x65\x99\xcd\x8d\xbf\xee\x22\xf5\x07\x8d\xbf\xfe\x16\xf6\x3b\xc6\x77\x4a\xad\xce\x19\xfc\x1a\x12\x0e\x0d\xb0\x71\xf9\x9e\x53\x2a\x3d\x23\xa3\x10\x8f\x60\x30\x38\xfe\xf0\xe1\xb4\x3d\x18\x38\xa5\x92\x5b\x0d\xf1\x55\x75\x14\xba\xaa\x58\x4d\xac\xce\xcb\xde\xcb\x6f\x4a\x68\x48\x46\x4e\x49\x75\xaf\x70\xd3\x3d\x37\xa0\x7b\x71\x7a\xba\x06\x81\xfc\x65\xfe\x5f\xa8\xbb\x02\x48\xad\x11\xcc\xa2\x79\x98\xd1\x0e\x47\x30\x0a\x85\x9a\x6e\x35\xbb\x9e\x5d\x31\x8c\x5d\x01\xbf\xaa\xff\x1a\x60\xa3\x8d\x15\x1c\xe5\x03\x50\x79\xbf\x18\x68\xcc\x43\xbe\x82\x81\xd0\xac\x64\xd0\x00\x24\x19\xf1\x94\x5a\x3b\x0a\xf5\x01\x1b\xad\x78\x92\x85\x49\x19\x04\x28\x0c\xf9\x40\x48\xc6\x95\x59\xa4\x1e\x14\xe4\xaa\x20\xc2\x74\x20\x75\x4e\x84\x29\x34\x12\xb5\xad\x32\x52\x9d\x93\xb9\x6a\xc0\x6a\x29\xbd\x95\x3d\xe3\x58\x1a\x19\x4e\x46\xf8\xf5\xc8\x7b\xa1\x5b\x56\xe0\x85\xed\x32\xc7\x12\x4a\x16\x24\xa1\x89\x16\x72\x8c\xf2\x42\x57\xb8\x60\xff\x9e\xb4\xc0\xdc\x9c\x3f\xc4\x23\xb5\x6a\x06\x13\x44\xc7\xc6\x55\xc7\x53\xdf\x22\x9e\xbd\x61\xbf\x01\x86\xb9\x46\x99\x0f\x2b\x24\xd0\xe7\xea\xdd\xa5\xea\x56\x7e\x9b\x41\x73\xa4\xea\x76\xc1\x95\x99\x19\x4f\x91\x30\x22\x9c\x5c\xf2\xef\x1b\xab\x02\x29\xf5\x20\x60\xc6\xa2\x88\xd0\xb1\x6f\xe1\xc9\x42\x7a\xff\x47\x06\x1e\x0c\xf1\x43\xbe\x57\xf0\x10\x64\x14\x88\x05\x83\x69\x14\x96\x97\xd6\x9a\xac\x99\xa5\xfc\x05\x50\x36\x80\x50\xd6\x40\x44\x84\xc4\xd4\x22\xbc\x72\xc6\xcf\xb0\xc0\x32\x43\xac\x3e\xe6\x57\x98\x0b\x90\x98\x6a\x4f\x64\xce\xf8\x25\x0c\xb1\x94\x98\x67\x0c\x3b\x22\x80\x32\xba\x31\x8c\x58\x70\xa9\x7a\x87\x3e\x9b\x62\x10\xb6\xad\xf5\x72\x80\xc8\x07\xd2\x39\xff\x51\x86\xff\x0d\x99\x8b\x9f\x77\x78\x14\xcb\x58\xad\xef\xc3\x27\x0c\xd4\x7a\x62\xfa\xac\x21\xbf\xc2\x0b\x52\xc2\x49\x4b\xac\xc3\x6b\x49\xf1\xde\x8f\x40\x4e\xdd\xde\x03\xe9\x28\x14\xfe\x2c\x16\x13\x7d\x6b\x48\x41\xc7\x94\x6e\x1d\x00\xb3\x84\x31\x7a\x85\xb9\xb1\x66\x8d\xf6\xd3\xef\xde\x94\x1b\x3c\x45\x90\x9c\x5e\x0d\x35\x46\xfe\x4a\xfb\x4f\xd1\xf2\xc0\x29\xa9\x3f\xda\x98\xd3\x1f\x3e\x51\x36\xd6\x88\x71\xcf\x5a\x4c\x04\x1a\x50\x3b\x00\x02\x87\x4a\x9d\xfa\xe6\xab\x20\x07\xf0\xea\x15\xb1\xfa\x70\xe4\x11\x38\x52\xb8\xa5\xdd\x54\x54\x17\x69\xaf\xa3\x50\x7c\x26\x5f\x57\xa8\xea\xc5\x22\xfd\xeb\x11\x20\x3e\x16\x07\x4e\x49\xfd\xc9\xa0\x3e\x24\x54\xab\xd5\xac\xcd\x27\xa4\x5a\x8d\x54\xef\x89\xbd\x57\x6c\x25\x24\x4f\x2c\x3a\xdd\xba\x50\xac\x57\x1b\xbd\x2e\x14\xe7\xec\xce\x6f\x83\xd8\xb5\x47\xdf\x9f\xa4\x00\xab\x80\x46\xc0\x68\x63\x8d\x85\xc9\xd0\x0a\x99\x8f\xc5\xe7\xda\xd7\xf2\xfa\x61\xf4\x77\x65\xf2\x4b\x6f\xca\x05\xb7\x8e\x7e\xc7\x6a\xde\x09\x7b\x66\x33\xad\xa2\x4d\x9e\xf2\xcf\xb7\x0f\xb9\x6a\xff\x8f\xd0\x10\x5f\xfb\xdf\xc4\xf7\x1a\xe3\x9e\xf3\x3f\xf5\xcd\x5a\xbd\xb8\xff\xb7\xb9\x53\x7f\xda\xff\xfb\x11\xe9\x69\xff\xef\x69\xff\xef\xbb\xed\xff\x19\x3d\xe2\x9b\x20\x61\xe3\xc0\x6a\x42\x7a\x2f\x93\xf8\x60\xfb\xce\xd2\x6a\x9a\x97\xe5\x83\x9f\x4f\x23\xfe\x7b\xa5\x55\xfa\x7f\x86\x82\x4b\x34\xc6\xfe\x37\xc1\xe8\xf7\x18\xe3\x1e\xfd\xbf\x55\xdb\xde\xce\x9c\xff\xac\x41\x6d\xb3\x56\xaf\x6d\x3e\xe9\xff\x1f\x91\x74\xf8\xee\x40\x19\x3c\xee\x3e\x7c\xd6\x27\x86\xcd\xff\xe9\xf1\xff\x3c\x73\xd8\x53\xc5\x50\x38\x64\x3d\x66\x55\xc1\x83\xea\x98\xc8\x49\x3c\xf4\x03\x36\x5d\x14\x04\x11\x8b\x43\x57\xb7\xfa\xea\x00\xe8\xa0\x5d\x77\x30\xe2\x4c\x9f\x72\x5f\x3b\x86\x3b\x20\x3a\x08\xd3\x64\xfd\x5e\x4b\x23\x85\xdc\x01\xa1\x4d\x1b\x19\x21\x79\x8c\x93\x3c\x1d\x58\x6a\xcf\x74\x2f\xf2\x93\x6b\xea\xcc\x61\xee\x6c\xf7\xb3\x09\xa2\x92\x4d\x9b\x13\x12\x85\x1c\xab\x0a\x7f\x99\xb8\xe1\x81\xd2\x5a\x58\x48\x1d\x8a\x66\x03\x87\x27\x2c\xf7\x0c\xe0\x86\x84\xe3\x40\x5e\x98\xf0\x9b\x34\x40\x04\xcd\x7d\x43\x03\x1b\x01\x24\x31\x95\x9a\x1c\xab\xf0\xac\x4e\x91\x90\x05\x79\x5b\xd0\x77\x4c\x92\xde\xc7\x44\xee\x57\xb3\xb4\x5d\xd5\x99\xbf\x38\x0a\x9e\x84\xe7\x2c\x9a\xbf\x4a\x00\x7c\x54\x27\x62\xc2\xb8\x0c\xe2\xe4\xa0\xf9\x24\x1e\xee\xdf\xcd\x12\x42\x4c\x6c\xdd\xdf\x17\x03\xad\x6c\x53\x18\x48\x4c\x32\xb0\x0a\x31\x31\x90\xfe\xfe\x28\x68\xe5\x8d\x89\xb3\x31\x8d\x0c\xc3\xdd\x26\x31\x0b\x26\x82\x8a\xc6\x51\x94\x84\xf7\xa0\xf9\x9d\xec\x67\xaa\xf4\x67\x38\xb8\xaf\x5a\x12\xe0\x93\xe9\x5c\xd8\x66\x8f\x12\x91\x95\x28\xa6\x21\x1e\x16\x39\xc3\x75\x8c\xdf\xb8\x69\x98\xfb\x20\xb1\x56\xde\xdc\xa4\x22\xec\x56\xdd\x85\xa8\x71\x2c\x58\x74\x65\x43\x9a\x49\x84\xd7\x4f\xa2\x3b\x10\x13\x24\x62\x2d\x98\xbb\x4a\x67\xe3\xdf\x76\xd0\xeb\xdf\x86\x3b\xdb\x7b\x7b\x5b\xbf\xed\x8c\xd0\xd6\xd6\xce\xce\xf6\x68\xeb\x35\x42\xbb\xc3\x61\x6d\xb8\xb5\x19\xd6\xd3\x86\x99\x78\x92\x84\x14\xee\x40\xdc\x47\x40\x77\x30\x9f\x60\xbe\x1c\xb5\x71\xaf\x42\xd1\x8d\x4d\xd8\x44\x1a\x17\xe1\x66\x8f\x22\x2e\x04\x3a\xc4\x57\xad\x95\x05\xe3\x9b\x99\x0d\xf4\x48\x15\x46\x26\x6e\xd0\xda\xb8\x9b\x89\xde\xd1\xfb\xcf\xfb\xe0\x26\xee\x91\xc9\x4d\x82\xf3\xb2\x38\x25\xa1\x18\xab\x47\xe5\x18\x85\xa6\x4d\xfb\xec\xac\x77\xb6\x0f\x5d\x06\x67\xed\xe3\xd6\xfb\x76\xf2\x56\x2b\xa6\xe1\x2f\xa6\xa7\x45\x04\xd7\x5f\x49\xb4\x88\xd6\x73\xae\xbd\x92\x60\x63\x7c\x33\x03\x1b\x5b\x97\xf2\x0a\x16\x5a\x64\x71\x30\x61\xf0\xc5\x6d\x73\xce\xf8\x3e\x50\x06\xaa\x60\xf1\x9a\xf0\x8b\x0b\x2f\x5e\x80\xf2\x5a\xa1\xbe\xe0\xa6\x4c\xb8\x90\x51\xb9\x4b\x11\x23\x4f\xe9\x5f\x29\x7d\x6f\x5f\x7f\x55\xba\xcf\xff\xdf\xda\x55\xf6\xdf\x56\xad\xbe\xf5\x7a\x7b\xb7\xb6\x09\xb5\xfa\xd6\xd6\xe6\x53\xfc\xcf\x0f\x49\x4f\xfe\xff\x93\xff\xff\xdd\xfc\xff\x41\xc6\xe5\x77\xfd\x2a\xa6\x57\x6a\xfa\xf3\xd9\x76\x9d\x54\xf9\x76\x9f\xc0\x9f\xe0\x28\x62\x9f\x18\x8f\x42\x68\x2c\x8e\x0d\x64\x72\x3d\x8e\xff\xa1\x5f\x5a\xeb\x3d\x6b\xfd\x56\x1c\x4b\xef\x65\xf6\x60\xc2\xcb\x0a\xbc\x5c\x1c\x4d\x78\x59\x3e\x48\xea\xd1\xd0\x73\x49\x7a\xee\x42\xbf\x27\xd9\xf7\x5c\xbd\x4d\xfb\xcf\x96\xbd\x9f\x21\x7d\x6f\x5f\x7f\x55\xba\xef\xfe\xbf\xed\x5d\xbb\xff\xbb\xab\x77\x83\xa1\x56\xdf\xae\x6f\x3f\x9d\xff\xfc\x21\xe9\xaf\xac\x0d\xcb\x19\x33\x0e\xd5\xb2\x19\x68\x0d\xe9\xdc\xfd\x20\x77\x99\xc5\x45\xcb\x7c\xc9\xae\xde\xd8\x5c\xf4\x9a\xb7\x92\xed\xcd\x18\x46\x4f\x24\x3e\x4b\x75\xb5\x7b\x94\xda\xad\x1c\xcf\x98\x20\xda\x33\x4a\xbb\xc8\x78\x84\x69\x8c\xfd\x1a\x8f\xda\x2c\x7a\x4d\xe5\x59\x7c\xb0\x37\x07\x55\xb5\x9f\xb1\x91\xe8\x23\xb1\x31\x66\xda\xe1\x5c\x19\x46\xfd\x94\x9e\xd2\x53\x7a\x4a\xff\xef\xd2\x7f\x07\x00\x00\xff\xff\x86\xd7\xb7\x0d\x00\xb6\x01\x00")
-
-func functionTarBytes() ([]byte, error) {
-	return bindataRead(
-		_functionTar,
-		"function.tar",
-	)
-}
-
-func functionTar() (*asset, error) {
-	bytes, err := functionTarBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "function.tar", size: 112128, mode: os.FileMode(436), modTime: time.Unix(1527110106, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"nodego/env.go":          nodegoEnvGo,
-	"nodego/http.go":         nodegoHttpGo,
-	"nodego/main.go":         nodegoMainGo,
-	"nodego/nodego.go":       nodegoNodegoGo,
-	"nodego/nodego_local.go": nodegoNodego_localGo,
-	"nodego/pubsub.go":       nodegoPubsubGo,
-	"nodego/storage.go":      nodegoStorageGo,
-	"nodego/supervisor.go":   nodegoSupervisorGo,
-	"nodego/types.go":        nodegoTypesGo,
-	"function.tar":           functionTar,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"function.tar": &bintree{functionTar, map[string]*bintree{}},
-	"nodego": &bintree{nil, map[string]*bintree{
-		"env.go":          &bintree{nodegoEnvGo, map[string]*bintree{}},
-		"http.go":         &bintree{nodegoHttpGo, map[string]*bintree{}},
-		"main.go":         &bintree{nodegoMainGo, map[string]*bintree{}},
-		"nodego.go":       &bintree{nodegoNodegoGo, map[string]*bintree{}},
-		"nodego_local.go": &bintree{nodegoNodego_localGo, map[string]*bintree{}},
-		"pubsub.go":       &bintree{nodegoPubsubGo, map[string]*bintree{}},
-		"storage.go":      &bintree{nodegoStorageGo, map[string]*bintree{}},
-		"supervisor.go":   &bintree{nodegoSupervisorGo, map[string]*bintree{}},
-		"types.go":        &bintree{nodegoTypesGo, map[string]*bintree{}},
-	}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
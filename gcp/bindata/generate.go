@@ -1,3 +0,0 @@
-package bindata
-
-//go:generate go-bindata -pkg bindata -prefix ../ ../nodego ../function.tar
@@ -1,21 +1,22 @@
 package gcp
 
 import (
-	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"compress/flate"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
-
+	"sort"
 	"strings"
+	"time"
 
 	"log"
 
-	"github.com/nwca/cloudfunc/gcp/bindata"
+	"github.com/nwca/cloudfunc"
 )
 
 func goPath() (string, error) {
@@ -26,15 +27,90 @@ func goPath() (string, error) {
 	return string(bytes.TrimSpace(data)), nil
 }
 
-func BuildTmp(tr Trigger, env map[string]string) (io.ReadCloser, error) {
+// BuildOptions are optional parameters for Build.
+type BuildOptions struct {
+	// Env is a set of environment variables for the function. They are set
+	// on the function by Deploy (see DeployEnv), unless EnvJS is set.
+	Env map[string]string
+	// EnvJS writes Env to env.js in the archive, for archives that are
+	// deployed without cloudfunc.
+	EnvJS bool
+	// Tags are additional build tags.
+	Tags []string
+	// LDFlags are passed to the linker in addition to the version stamp.
+	LDFlags string
+	// Flags are additional flags for go build.
+	Flags []string
+	// Include is a list of additional files for the archive.
+	Include []Include
+	// Strip removes the symbol table and debug information from the binary.
+	Strip bool
+	// Compression is the deflate level of the archive, from 1 to 9.
+	// Zero value selects the default level.
+	Compression int
+	// SizeBudget fails the build if the compressed archive is larger.
+	SizeBudget int64
+	// Smoke starts the built function locally and sends a synthetic request
+	// matching the trigger before packaging it.
+	Smoke bool
+	// Shim is a template of the node wrapper. DefaultShim is used if not set.
+	Shim *Shim
+	// Runtime is the runtime of the function. For native Go runtimes the
+	// archive contains the module source and the function is built by the
+	// platform. Otherwise, the binary is started by the node shim.
+	Runtime string
+	// SecretResolver is the import path of a package that registers a
+	// resolver with cloudfunc.SetSecretResolver in its init. The package is
+	// imported by the launcher that resolves secrets before the function
+	// binary starts.
+	SecretResolver string
+}
+
+// launcher reports if the build adds the launcher that resolves secrets before
+// the function binary starts: the environment references secrets or a secret
+// resolver is set. Otherwise, secrets set later are resolved by Init.
+func (opt *BuildOptions) launcher() bool {
+	if opt.SecretResolver != "" {
+		return true
+	}
+	for _, v := range opt.Env {
+		if cloudfunc.IsSecretRef(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (opt *BuildOptions) shim() *Shim {
+	if opt.Shim != nil {
+		return opt.Shim
+	}
+	return DefaultShim()
+}
+
+func BuildTmp(tr Trigger, opt *BuildOptions) (io.ReadCloser, error) {
 	buf := bytes.NewBuffer(nil)
-	if err := Build(tr, env, buf); err != nil {
+	if err := Build(tr, opt, buf); err != nil {
 		return nil, err
 	}
 	return ioutil.NopCloser(buf), nil
 }
 
-func Build(tr Trigger, env map[string]string, out io.Writer) error {
+func Build(tr Trigger, opt *BuildOptions, out io.Writer) error {
+	if opt == nil {
+		opt = &BuildOptions{}
+	}
+	if opt.Compression < 0 || opt.Compression > flate.BestCompression {
+		return fmt.Errorf("invalid compression level: %d", opt.Compression)
+	}
+	if IsNativeRuntime(opt.Runtime) {
+		return buildNative(tr, opt, out)
+	}
+	sh := opt.shim()
+	files, err := includedFiles(opt.Include, sh)
+	if err != nil {
+		return err
+	}
 	dir, err := ioutil.TempDir("", "cloudfunc-")
 	if err != nil {
 		return err
@@ -42,46 +118,70 @@ func Build(tr Trigger, env map[string]string, out io.Writer) error {
 	log.Println("build dir:", dir)
 	defer os.RemoveAll(dir)
 
-	if err := unpackBindata("nodego", dir); err != nil {
+	if err := unpackNodego(dir); err != nil {
 		return fmt.Errorf("cannot unpack template: %v", err)
 	}
 	err = writeImpl(dir, tr.writeSource)
 	if err != nil {
 		return fmt.Errorf("cannot write import: %v", err)
 	}
-	bin := filepath.Join(dir, "main")
-	if err := goBuild(bin, dir, tr.buildTags()); err != nil {
-		return fmt.Errorf("cannot build binary: %v", err)
+	if err = writeResolver(dir, opt.SecretResolver); err != nil {
+		return fmt.Errorf("cannot write secret resolver import: %v", err)
+	}
+	mod := tr.target().Module
+	if mod != nil {
+		if err := writeGoMod(dir, mod); err != nil {
+			return fmt.Errorf("cannot write go.mod: %v", err)
+		}
+	}
+	st := readStamp(tr.target().Dir)
+	st.Shim = sh.Version()
+	log.Printf("stamping commit %q (dirty: %v)", st.Commit, st.Dirty)
+	bin, err := goBuildAll(dir, tr, opt, st, mod != nil, false)
+	if err != nil {
+		return err
 	}
 	if err := testBin(bin); err != nil {
 		return err
 	}
+	var jsEnv map[string]string
+	if opt.EnvJS {
+		jsEnv = opt.Env
+	}
 	envjs := filepath.Join(dir, "env.js")
-	err = writeEnvJS(envjs, env)
+	err = writeEnvJS(envjs, jsEnv)
 	if err != nil {
 		return fmt.Errorf("cannot write env: %v", err)
 	}
-	if err := repackTar2ZipWith("function.tar", out, bin, envjs); err != nil {
-		return err
+	shimVer := filepath.Join(dir, ShimVersionFile)
+	if err = ioutil.WriteFile(shimVer, []byte(st.Shim+"\n"), 0644); err != nil {
+		return fmt.Errorf("cannot write shim version: %v", err)
 	}
-	return nil
-}
-
-func unpackBindata(from, to string) error {
-	files, err := bindata.AssetDir(from)
-	if err != nil {
-		return err
-	}
-	for _, name := range files {
-		data, err := bindata.Asset(filepath.Join(from, name))
-		if err != nil {
-			return err
+	log.Println("shim version:", st.Shim)
+	if opt.Smoke {
+		if err := linkFiles(dir, files); err != nil {
+			return fmt.Errorf("cannot prepare smoke test: %v", err)
 		}
-		if err = ioutil.WriteFile(filepath.Join(to, name), data, 0644); err != nil {
-			return err
+		res, err := smokeTest(bin, dir, tr, opt.Env)
+		logSmokeResult(res)
+		if err != nil {
+			return fmt.Errorf("smoke test failed: %v", err)
 		}
 	}
-	return nil
+	bins := []archiveFile{{Name: "main", Path: bin}}
+	if opt.launcher() {
+		bins = append(bins, archiveFile{Name: functionBin, Path: filepath.Join(dir, functionBin)})
+	}
+	files = append(append(bins,
+		archiveFile{Name: "env.js", Path: envjs},
+		archiveFile{Name: ShimVersionFile, Path: shimVer},
+	), files...)
+	size, err := writeArchive(sh, out, opt.Compression, files...)
+	if err != nil {
+		return err
+	}
+	log.Println("archive size:", size)
+	return size.Check(opt.SizeBudget)
 }
 
 func writeSource(file string, fnc func(w io.Writer) error) error {
@@ -100,32 +200,145 @@ func writeImpl(dir string, fnc func(w io.Writer) error) error {
 	return writeSource(filepath.Join(dir, "impl.go"), fnc)
 }
 
+// writeResolver imports the secret resolver package into the launcher and
+// the function binary, if it's set.
+func writeResolver(dir, pkg string) error {
+	if pkg == "" {
+		return nil
+	}
+	for _, d := range []string{dir, filepath.Join(dir, launchDir)} {
+		err := writeSource(filepath.Join(d, "resolver.go"), func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "// Code generated by cloudfunc. DO NOT EDIT.\n\npackage main\n\nimport _ %q\n", pkg)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEnvJS writes a script that sets environment variables for the shim.
+// The shim requires it even if there are no variables.
 func writeEnvJS(dst string, env map[string]string) error {
-	log.Printf("writing %d environment variables", len(env))
+	if len(env) != 0 {
+		log.Printf("writing %d environment variables to env.js", len(env))
+	}
+	keys := make([]string, 0, len(env))
+	for k := range env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
 	return writeSource(dst, func(w io.Writer) error {
 		var last error
-		for k, v := range env {
-			_, last = fmt.Fprintf(w, "process.env[%q] = %q;\n", k, v)
+		for _, k := range keys {
+			_, last = fmt.Fprintf(w, "process.env[%q] = %q;\n", k, env[k])
 		}
 		return last
 	})
 }
 
-func goBuild(out string, dir string, tags []string) error {
+// goCommand prepares a go command that runs in a given directory and
+// inherits the environment, so private modules and proxies keep working.
+func goCommand(dir string, args ...string) *exec.Cmd {
+	cmd := exec.Command("go", args...)
+	cmd.Env = os.Environ()
+	cmd.Stderr = os.Stderr
+	cmd.Dir = dir
+	return cmd
+}
+
+// setBuildEnv configures the command to target the cloud functions platform,
+// either in module or in GOPATH mode.
+func setBuildEnv(cmd *exec.Cmd, modules bool) error {
+	cmd.Env = append(cmd.Env,
+		`GOARCH=amd64`,
+		`GOOS=linux`,
+		`CGO_ENABLED=0`,
+	)
+	return setModeEnv(cmd, modules)
+}
+
+// setModeEnv configures the command to build either in module or in GOPATH mode.
+func setModeEnv(cmd *exec.Cmd, modules bool) error {
+	if modules {
+		cmd.Env = append(cmd.Env, `GO111MODULE=on`)
+		return nil
+	}
 	gopath, err := goPath()
 	if err != nil {
 		return err
 	}
-	tags = append(tags, "node")
-	cmd := exec.Command("go", "build", "-tags", strings.Join(tags, " "), "-o", out)
 	cmd.Env = append(cmd.Env,
-		`GOARCH=amd64`,
-		`GOOS=linux`,
-		`CGO_ENABLED=0`,
+		`GO111MODULE=off`,
 		`GOPATH=`+gopath,
 	)
-	cmd.Stderr = os.Stderr
-	cmd.Dir = dir
+	return nil
+}
+
+func buildTags(tr Trigger, opt *BuildOptions) []string {
+	tags := append(tr.buildTags(), opt.Tags...)
+	return append(tags, "node")
+}
+
+// functionBin is the name of the function binary. The node shim starts main,
+// the launcher that resolves secrets and then starts the function binary.
+const functionBin = "function"
+
+// launchDir is the directory of the launcher in the template.
+const launchDir = "launch"
+
+// goBuildAll builds the function binary and returns the path of the binary
+// started by the shim. If the build needs the launcher, it's built as main and
+// the function binary is built next to it.
+func goBuildAll(dir string, tr Trigger, opt *BuildOptions, st stamp, modules, local bool) (string, error) {
+	bin := filepath.Join(dir, "main")
+	if !opt.launcher() {
+		if err := goBuild(bin, ".", dir, tr, opt, st, modules, local); err != nil {
+			return "", fmt.Errorf("cannot build binary: %v", err)
+		}
+		return bin, nil
+	}
+	if err := goBuild(filepath.Join(dir, functionBin), ".", dir, tr, opt, st, modules, local); err != nil {
+		return "", fmt.Errorf("cannot build binary: %v", err)
+	}
+	// the launcher has no user code, its symbols are of no use
+	lopt := *opt
+	lopt.Strip = true
+	if err := goBuild(bin, "./"+launchDir, dir, tr, &lopt, st, modules, local); err != nil {
+		return "", fmt.Errorf("cannot build launcher: %v", err)
+	}
+	return bin, nil
+}
+
+// goBuild builds a package of the template for the platform, or for the host
+// without the node shim if local is set.
+func goBuild(out, pkg, dir string, tr Trigger, opt *BuildOptions, st stamp, modules, local bool) error {
+	ldflags := st.ldflags()
+	if opt.Strip {
+		ldflags = "-s -w " + ldflags
+	}
+	if opt.LDFlags != "" {
+		ldflags = opt.LDFlags + " " + ldflags
+	}
+	tags := buildTags(tr, opt)
+	if local {
+		tags = append(tr.buildTags(), opt.Tags...)
+	}
+	args := []string{"build", "-trimpath",
+		"-tags", strings.Join(tags, " "),
+		"-ldflags", ldflags,
+	}
+	args = append(args, opt.Flags...)
+	args = append(args, "-o", out, pkg)
+	cmd := goCommand(dir, args...)
+	setEnv := setBuildEnv
+	if local {
+		setEnv = setModeEnv
+	}
+	if err := setEnv(cmd, modules); err != nil {
+		return err
+	}
 	return cmd.Run()
 }
 
@@ -141,67 +354,88 @@ func testBin(bin string) error {
 	return nil
 }
 
-func repackTar2ZipWith(from string, to io.Writer, add ...string) error {
-	arch, err := bindata.Asset(from)
-	if err != nil {
-		return err
-	}
-	tr := tar.NewReader(bytes.NewReader(arch))
+// archiveTime is the modification time of all archive entries.
+var archiveTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
 
-	zw := zip.NewWriter(to)
-	for {
-		h, err := tr.Next()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
-		}
-		fi := h.FileInfo()
+// normalizeHeader resets the time and permissions of the archive entry,
+// so identical inputs produce byte-identical archives. All entries are
+// compressed.
+func normalizeHeader(h *zip.FileHeader, mode os.FileMode) {
+	h.Modified = archiveTime
+	h.Method = zip.Deflate
+	switch {
+	case mode.IsDir():
+		h.SetMode(os.ModeDir | 0755)
+	case mode&0111 != 0:
+		h.SetMode(0755)
+	default:
+		h.SetMode(0644)
+	}
+}
 
-		zh, err := zip.FileInfoHeader(fi)
-		if err != nil {
-			return err
-		}
-		zh.Name = h.Name
+// writeArchive writes the function archive with the shim files and
+// additional local files.
+func writeArchive(sh *Shim, to io.Writer, level int, add ...archiveFile) (ArchiveSize, error) {
+	var size ArchiveSize
+	cw := &countWriter{w: to}
+	zw := zip.NewWriter(cw)
+	if level != 0 {
+		zw.RegisterCompressor(zip.Deflate, func(w io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(w, level)
+		})
+	}
+	for _, f := range sh.files {
+		zh := &zip.FileHeader{Name: f.Name}
+		normalizeHeader(zh, f.Mode)
 		w, err := zw.CreateHeader(zh)
 		if err != nil {
-			return fmt.Errorf("cannot copy zip file: %v", err)
+			return size, fmt.Errorf("cannot copy zip file: %v", err)
 		}
-		_, err = io.Copy(w, tr)
-		if err != nil {
-			return fmt.Errorf("cannot copy zip file: %v", err)
+		if _, err = w.Write(f.Data); err != nil {
+			return size, fmt.Errorf("cannot copy zip file: %v", err)
 		}
+		size.Shim += int64(len(f.Data))
 	}
-	for _, fname := range add {
-		f, err := os.Open(fname)
+	for _, af := range add {
+		f, err := os.Open(af.Path)
 		if err != nil {
-			return err
+			return size, err
 		}
 		st, err := f.Stat()
 		if err != nil {
 			f.Close()
-			return err
+			return size, err
 		}
 		h, err := zip.FileInfoHeader(st)
 		if err != nil {
 			f.Close()
-			return err
+			return size, err
 		}
-		h.Name = filepath.Base(fname)
+		h.Name = af.Name
+		normalizeHeader(h, st.Mode())
 
 		w, err := zw.CreateHeader(h)
 		if err != nil {
 			f.Close()
-			return err
+			return size, err
 		}
-		_, err = io.Copy(w, f)
+		n, err := io.Copy(w, f)
+		f.Close()
 		if err != nil {
-			f.Close()
-			return err
+			return size, err
+		}
+		switch af.Name {
+		case "main", functionBin:
+			size.Binary += n
+		case ShimVersionFile:
+			size.Shim += n
+		default:
+			size.Extra += n
 		}
 	}
-	if err = zw.Close(); err != nil {
-		return fmt.Errorf("flush failed: %v", err)
+	if err := zw.Close(); err != nil {
+		return size, fmt.Errorf("flush failed: %v", err)
 	}
-	return nil
+	size.Compressed = cw.n
+	return size, nil
 }
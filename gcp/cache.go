@@ -0,0 +1,234 @@
+package gcp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// HashLabel is the function label that records the hash of build inputs.
+const HashLabel = "cloudfunc-hash"
+
+// BuildHash returns a hash of all inputs of the function build: the Go package,
+// the secret resolver package and their dependencies, the trigger, environment, included files, runtime shim
+// and build flags.
+// The version stamp is not included, so the cached archives keep the stamp of
+// the build that produced them.
+//
+// The hash is safe to use as a label value.
+func BuildHash(tr Trigger, opt *BuildOptions) (string, error) {
+	if opt == nil {
+		opt = &BuildOptions{}
+	}
+	h := sha256.New()
+
+	t := tr.target()
+	fmt.Fprintf(h, "trigger %T %q %q %q\n", tr, t.Package, t.Func, tr.gcloudArgs())
+	tags := buildTags(tr, opt)
+	fmt.Fprintf(h, "tags %q\n", tags)
+	fmt.Fprintf(h, "ldflags %q\n", opt.LDFlags)
+	fmt.Fprintf(h, "flags %q\n", opt.Flags)
+	fmt.Fprintf(h, "strip %v\n", opt.Strip)
+	fmt.Fprintf(h, "compression %d\n", opt.Compression)
+	fmt.Fprintf(h, "runtime %q\n", opt.Runtime)
+	fmt.Fprintf(h, "secret resolver %q %v\n", opt.SecretResolver, opt.launcher())
+
+	// environment is only a part of the archive if it's written to env.js
+	if opt.EnvJS {
+		keys := make([]string, 0, len(opt.Env))
+		for k := range opt.Env {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fmt.Fprintf(h, "env %q=%q\n", k, opt.Env[k])
+		}
+	}
+
+	sh := opt.shim()
+	files, err := includedFiles(opt.Include, sh)
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if err = hashFile(h, f.Path, f.Name); err != nil {
+			return "", err
+		}
+	}
+
+	fmt.Fprintf(h, "shim %q\n", sh.Version())
+	cfVersion, cfReplace := selfModule()
+	fmt.Fprintf(h, "cloudfunc %q %q\n", cfVersion, cfReplace)
+	if err := hashPackage(h, t, tags); err != nil {
+		return "", err
+	}
+	if opt.SecretResolver != "" {
+		r := Target{Package: opt.SecretResolver, Dir: t.Dir, Module: t.Module}
+		if err := hashPackage(h, r, tags); err != nil {
+			return "", err
+		}
+	}
+	sum := h.Sum(nil)
+	return hex.EncodeToString(sum[:20]), nil
+}
+
+type depPackage struct {
+	ImportPath string
+	Dir        string
+	Standard   bool
+	Module     *struct {
+		Path    string
+		Version string
+		Replace *struct {
+			Path    string
+			Version string
+		}
+	}
+	GoFiles    []string
+	CgoFiles   []string
+	SFiles     []string
+	HFiles     []string
+	EmbedFiles []string
+}
+
+// hashPackage hashes the sources of the target package and all its
+// non-standard dependencies. Dependencies from the module cache are
+// identified by their version only.
+func hashPackage(h hash.Hash, t Target, tags []string) error {
+	dir := ""
+	if t.Module != nil {
+		dir = t.Module.Dir
+	}
+	ver := goCommand(dir, "version")
+	if err := setBuildEnv(ver, t.Module != nil); err != nil {
+		return err
+	}
+	out, err := ver.Output()
+	if err != nil {
+		return err
+	}
+	h.Write(out)
+
+	cmd := goCommand(dir, "list", "-deps", "-json", "-tags", strings.Join(tags, " "), t.Package)
+	if err := setBuildEnv(cmd, t.Module != nil); err != nil {
+		return err
+	}
+	r, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(r)
+	for {
+		var p depPackage
+		if err = dec.Decode(&p); err == io.EOF {
+			break
+		} else if err != nil {
+			cmd.Wait()
+			return err
+		}
+		if p.Standard {
+			continue
+		}
+		fmt.Fprintf(h, "package %q\n", p.ImportPath)
+		if m := p.Module; m != nil && m.Version != "" && m.Replace == nil {
+			fmt.Fprintf(h, "module %q %q\n", m.Path, m.Version)
+			continue
+		}
+		if m := p.Module; m != nil && m.Replace != nil && m.Replace.Version != "" {
+			fmt.Fprintf(h, "module %q %q\n", m.Replace.Path, m.Replace.Version)
+			continue
+		}
+		var files []string
+		for _, list := range [][]string{p.GoFiles, p.CgoFiles, p.SFiles, p.HFiles, p.EmbedFiles} {
+			files = append(files, list...)
+		}
+		sort.Strings(files)
+		for _, name := range files {
+			if err = hashFile(h, filepath.Join(p.Dir, name), name); err != nil {
+				cmd.Wait()
+				return err
+			}
+		}
+	}
+	return cmd.Wait()
+}
+
+func hashFile(h hash.Hash, path, name string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(h, "file %q %d\n", name, st.Size())
+	_, err = io.Copy(h, f)
+	return err
+}
+
+// DefaultCacheDir returns the default directory for cached function archives.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "cloudfunc"), nil
+}
+
+// BuildCached is like BuildTmp, but reuses an archive previously built from
+// the same inputs. The hash of build inputs must be computed by BuildHash.
+// If the smoke test is enabled, the function is always rebuilt and tested,
+// and the new archive replaces the cached one.
+func BuildCached(tr Trigger, opt *BuildOptions, hash, cacheDir string) (io.ReadCloser, error) {
+	if opt == nil {
+		opt = &BuildOptions{}
+	}
+	if err := os.MkdirAll(cacheDir, 0755); err != nil {
+		return nil, err
+	}
+	path := filepath.Join(cacheDir, hash+".zip")
+	if opt.Smoke {
+		log.Println("smoke test is enabled, not using cached build")
+	} else if f, err := os.Open(path); err == nil {
+		log.Println("using cached build:", path)
+		if err = CheckArchiveFile(f, opt.SizeBudget); err != nil {
+			f.Close()
+			return nil, err
+		}
+		return f, nil
+	}
+	tmp, err := ioutil.TempFile(cacheDir, hash+"-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	if err = Build(tr, opt, tmp); err != nil {
+		tmp.Close()
+		return nil, err
+	}
+	if err = tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
@@ -2,19 +2,16 @@ package gcp
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"io"
-	"math/rand"
+	"net/http"
+	"sync"
 
-	"cloud.google.com/go/longrunning"
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
 	longauto "cloud.google.com/go/longrunning/autogen"
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/option"
 	"google.golang.org/api/transport"
-	funcs "google.golang.org/genproto/googleapis/cloud/functions/v1beta2"
-	longpb "google.golang.org/genproto/googleapis/longrunning"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 const region = "us-central1"
@@ -25,30 +22,87 @@ func defaultFuncsClientOptions() []option.ClientOption {
 	return []option.ClientOption{
 		option.WithEndpoint("cloudfunctions.googleapis.com:443"),
 		option.WithScopes([]string{
-			"https://www.googleapis.com/auth/cloud-platform",
+			cloudPlatformScope,
 			scope,
 		}...),
 	}
 }
 
-func NewClient(project string) (*Client, error) {
+// ClientOption configures a Client.
+type ClientOption func(c *clientConfig)
+
+type clientConfig struct {
+	funcs   []option.ClientOption
+	storage []option.ClientOption
+	long    *longauto.OperationsClient
+	http    *http.Client
+	auth    authConfig
+}
+
+// WithFunctionsOptions sets options of the Cloud Functions client, such as
+// the endpoint. The options are also used for the operations client, unless
+// it is set with WithOperationsClient.
+func WithFunctionsOptions(opts ...option.ClientOption) ClientOption {
+	return func(c *clientConfig) {
+		c.funcs = append(c.funcs, opts...)
+	}
+}
+
+// WithStorageOptions sets options of the Cloud Storage client, such as the endpoint.
+func WithStorageOptions(opts ...option.ClientOption) ClientOption {
+	return func(c *clientConfig) {
+		c.storage = append(c.storage, opts...)
+	}
+}
+
+// WithOperationsClient sets the client used to wait for deploy operations.
+// The client is closed with the Client.
+func WithOperationsClient(long *longauto.OperationsClient) ClientOption {
+	return func(c *clientConfig) {
+		c.long = long
+	}
+}
+
+// WithHTTPClient sets the client used to upload archives to signed URLs.
+func WithHTTPClient(cli *http.Client) ClientOption {
+	return func(c *clientConfig) {
+		c.http = cli
+	}
+}
+
+func NewClient(project string, opts ...ClientOption) (*Client, error) {
+	conf := &clientConfig{http: http.DefaultClient}
+	for _, o := range opts {
+		o(conf)
+	}
 	ctx := context.Background()
-	conn, err := transport.DialGRPC(ctx, defaultFuncsClientOptions()...)
+	auth, err := conf.auth.options(ctx)
 	if err != nil {
 		return nil, err
 	}
-	cli := funcs.NewCloudFunctionsServiceClient(conn)
-	scli, err := storage.NewClient(ctx)
+	fopts := append(defaultFuncsClientOptions(), auth...)
+	fopts = append(fopts, conf.funcs...)
+	sopts := append(append([]option.ClientOption{}, auth...), conf.storage...)
+
+	conn, err := transport.DialGRPC(ctx, fopts...)
 	if err != nil {
-		conn.Close()
 		return nil, err
 	}
-	long, err := longauto.NewOperationsClient(ctx, defaultFuncsClientOptions()...)
+	cli := funcs.NewCloudFunctionsServiceClient(conn)
+	scli, err := storage.NewClient(ctx, sopts...)
 	if err != nil {
 		conn.Close()
-		scli.Close()
 		return nil, err
 	}
+	long := conf.long
+	if long == nil {
+		long, err = longauto.NewOperationsClient(ctx, fopts...)
+		if err != nil {
+			conn.Close()
+			scli.Close()
+			return nil, err
+		}
+	}
 	return &Client{
 		project: project,
 		funcs:   cli,
@@ -56,9 +110,13 @@ func NewClient(project string) (*Client, error) {
 		long:    long,
 		conn:    conn,
 		region:  region,
+		http:    conf.http,
+		auth:    conf.auth,
 	}, nil
 }
 
+// Client deploys and manages functions of a project. It is safe for
+// concurrent use.
 type Client struct {
 	project string
 	region  string
@@ -66,7 +124,12 @@ type Client struct {
 	funcs   funcs.CloudFunctionsServiceClient
 	storage *storage.Client
 	long    *longauto.OperationsClient
-	staging string
+	http    *http.Client
+	auth    authConfig
+
+	mu       sync.Mutex // guards staging and identity
+	staging  string
+	identity *string
 }
 
 func (c *Client) Close() error {
@@ -76,92 +139,34 @@ func (c *Client) Close() error {
 	return nil
 }
 
-func (c *Client) getBucket(ctx context.Context) (string, error) {
-	if c.staging != "" {
-		return c.staging, nil
+// getBucket checks that the staging bucket exists and creates it in a given
+// location otherwise.
+func (c *Client) getBucket(ctx context.Context, name, location string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.staging == name {
+		return nil
 	}
-	name := c.project + "-staging"
 	_, err := c.storage.Bucket(name).Attrs(ctx)
 	if err == nil {
 		c.staging = name
-		return name, nil
-	} else if err != nil && err != storage.ErrBucketNotExist {
-		return "", err
+		return nil
+	} else if !errors.Is(err, storage.ErrBucketNotExist) {
+		return err
+	}
+	if location == "" {
+		location = c.region
 	}
 	err = c.storage.Bucket(name).Create(ctx, c.project, &storage.BucketAttrs{
-		Name: name, StorageClass: "REGIONAL", Location: c.region,
+		Name: name, StorageClass: "STANDARD", Location: location,
 	})
 	if err != nil {
-		return "", err
+		return err
 	}
 	c.staging = name
-	return name, nil
+	return nil
 }
 
 func (c *Client) functionID(name string) string {
 	return "projects/" + c.project + "/locations/" + c.region + "/functions/" + name
 }
-
-func (c *Client) Deploy(ctx context.Context, name string, tr Trigger, r io.Reader) error {
-	f, err := c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{
-		Name: c.functionID(name),
-	})
-	create := false
-	if status.Code(err) == codes.NotFound {
-		f = &funcs.CloudFunction{
-			Name:       c.functionID(name),
-			EntryPoint: "helloWorld",
-		}
-		create = true
-	} else if err != nil {
-		return err
-	}
-	tr.setOn(c.project, f)
-
-	staging, err := c.getBucket(ctx)
-	if err != nil {
-		return err
-	}
-	b := c.storage.Bucket(staging)
-	fname := fmt.Sprintf("%s-%d.zip", name, rand.Int())
-
-	wctx, cancel := context.WithCancel(ctx)
-	w := b.Object(fname).NewWriter(wctx)
-	_, err = io.Copy(w, r)
-	if err != nil {
-		cancel()
-		return err
-	}
-	err = w.Close()
-	cancel()
-	if err != nil {
-		return err
-	}
-	defer b.Object(fname).Delete(ctx)
-	f.SourceCode = &funcs.CloudFunction_SourceArchiveUrl{
-		SourceArchiveUrl: "gs://" + staging + "/" + fname,
-	}
-
-	var (
-		oppb *longpb.Operation
-	)
-	if create {
-		oppb, err = c.funcs.CreateFunction(ctx, &funcs.CreateFunctionRequest{
-			Location: "projects/" + c.project + "/locations/" + c.region,
-			Function: f,
-		})
-	} else {
-		oppb, err = c.funcs.UpdateFunction(ctx, &funcs.UpdateFunctionRequest{
-			Name:     c.functionID(name),
-			Function: f,
-		})
-	}
-	if err != nil {
-		return fmt.Errorf("cannot update function: %v", err)
-	}
-	op := longrunning.InternalNewOperation(c.long, oppb)
-	if err = op.Wait(ctx, nil); err != nil {
-		return fmt.Errorf("cannot check operation state: %v", err)
-	}
-	return nil
-}
@@ -0,0 +1,578 @@
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+	"cloud.google.com/go/longrunning"
+	"github.com/golang/protobuf/ptypes"
+	longpb "google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// uploadChunkSize controls how often the upload progress is reported.
+	uploadChunkSize = 1 << 20
+	// pollInterval is the interval between checks of the deploy operation.
+	pollInterval = 3 * time.Second
+	// cleanupTimeout limits the cleanup after a failed or interrupted deploy.
+	cleanupTimeout = 30 * time.Second
+)
+
+// Phase is a phase of the deploy.
+type Phase int
+
+const (
+	PhaseUpload Phase = iota // uploading the archive
+	PhaseCreate              // creating a new function
+	PhaseUpdate              // updating an existing function
+	PhaseWait                // waiting for the operation to complete
+	PhaseDone                // the function is deployed
+)
+
+func (p Phase) String() string {
+	switch p {
+	case PhaseUpload:
+		return "uploading"
+	case PhaseCreate:
+		return "creating"
+	case PhaseUpdate:
+		return "updating"
+	case PhaseWait:
+		return "waiting"
+	case PhaseDone:
+		return "done"
+	}
+	return fmt.Sprintf("Phase(%d)", int(p))
+}
+
+// Progress describes the state of the deploy.
+type Progress struct {
+	Phase Phase
+	// Bytes is the number of uploaded bytes.
+	Bytes int64
+	// Total is the size of the archive, or zero if unknown.
+	Total int64
+	// Operation is the name of the deploy operation, once it is started.
+	Operation string
+	// Elapsed is the time since the start of the deploy.
+	Elapsed time.Duration
+}
+
+// DeployOptions are optional parameters for Deploy.
+type DeployOptions struct {
+	// Hash of the build inputs, as returned by BuildHash.
+	// It is recorded in the HashLabel of the function.
+	Hash string
+	// Runtime of the function. See BuildOptions.Runtime.
+	Runtime string
+	// Env is a set of environment variables for the function. It replaces
+	// all variables of an existing function. If nil, the variables are
+	// left unchanged. See DeployEnv.
+	Env map[string]string
+	// Labels of the function. If set, they replace all labels of the function,
+	// except the ones set by Deploy. Labels with the "cloudfunc-" prefix are
+	// reserved.
+	Labels map[string]string
+	// VPCConnector is the name of a Serverless VPC Access connector the
+	// function connects to, either short or in the form of
+	// projects/*/locations/*/connectors/*.
+	VPCConnector string
+	// Ingress controls which traffic can reach the function: "all",
+	// "internal-only" or "internal-and-gclb".
+	Ingress string
+	// MaxInstances limits the number of function instances.
+	MaxInstances int
+	// Memory available to the function, in MB.
+	Memory int
+	// Timeout of the function execution.
+	Timeout time.Duration
+	// Progress is called on each phase of the deploy and periodically
+	// during the upload and while waiting for the operation.
+	Progress func(p Progress)
+	// StagingBucket is a bucket for the uploaded archive. It is created in
+	// StagingLocation if it doesn't exist. If not set, the archive is uploaded
+	// to a URL provided by the Functions API.
+	StagingBucket string
+	// StagingLocation of the staging bucket. Defaults to the function region.
+	StagingLocation string
+	// Keep is the number of archives of the function to keep in the staging
+	// bucket for Rollback. Requires StagingBucket.
+	Keep int
+	// Commit of the function sources, recorded with kept archives and in
+	// the CommitLabel. See SourceCommit.
+	Commit string
+	// Branch of the function sources, recorded in the BranchLabel.
+	// See SourceBranch.
+	Branch string
+	// Deployer is recorded in the DeployerLabel. Defaults to the identity of
+	// the client.
+	Deployer string
+	// NoMetadata disables labels with the version of cloudfunc, the commit,
+	// the branch and the deployer. The HashLabel is still set.
+	NoMetadata bool
+}
+
+// IngressSettings maps names of ingress settings used by DeployOptions.Ingress
+// to their API values.
+var IngressSettings = map[string]funcs.CloudFunction_IngressSettings{
+	"all":               funcs.CloudFunction_ALLOW_ALL,
+	"internal-only":     funcs.CloudFunction_ALLOW_INTERNAL_ONLY,
+	"internal-and-gclb": funcs.CloudFunction_ALLOW_INTERNAL_AND_GCLB,
+}
+
+// apply sets function settings from the options. Unset options leave the
+// settings of an existing function unchanged.
+func (opt *DeployOptions) apply(f *funcs.CloudFunction) error {
+	if opt.VPCConnector != "" {
+		f.VpcConnector = opt.VPCConnector
+	}
+	if opt.Ingress != "" {
+		v, ok := IngressSettings[opt.Ingress]
+		if !ok {
+			return fmt.Errorf("unknown ingress setting %q", opt.Ingress)
+		}
+		f.IngressSettings = v
+	}
+	if opt.MaxInstances < 0 {
+		return fmt.Errorf("invalid max instances: %d", opt.MaxInstances)
+	} else if opt.MaxInstances > 0 {
+		f.MaxInstances = int32(opt.MaxInstances)
+	}
+	if opt.Memory < 0 {
+		return fmt.Errorf("invalid memory: %d", opt.Memory)
+	} else if opt.Memory > 0 {
+		f.AvailableMemoryMb = int32(opt.Memory)
+	}
+	if opt.Timeout < 0 || opt.Timeout%time.Second != 0 {
+		return fmt.Errorf("invalid timeout: %v, must be whole seconds", opt.Timeout)
+	} else if opt.Timeout > 0 {
+		f.Timeout = ptypes.DurationProto(opt.Timeout)
+	}
+	return nil
+}
+
+// progress reports the state of the deploy started at a given time.
+type progress struct {
+	fnc   func(p Progress)
+	start time.Time
+}
+
+func newProgress(fnc func(p Progress)) *progress {
+	return &progress{fnc: fnc, start: time.Now()}
+}
+
+func (p *progress) report(pr Progress) {
+	if p.fnc == nil {
+		return
+	}
+	pr.Elapsed = time.Since(p.start)
+	p.fnc(pr)
+}
+
+// Deploy uploads the function archive, creates or updates the function and
+// waits for the operation to complete.
+//
+// If the context is cancelled while waiting, Deploy attempts to cancel the
+// operation. The operation can be waited on again with Wait.
+func (c *Client) Deploy(ctx context.Context, name string, tr Trigger, r io.Reader, opt *DeployOptions) error {
+	if opt == nil {
+		opt = &DeployOptions{}
+	}
+	pr := newProgress(opt.Progress)
+	op, err := c.startDeploy(ctx, name, tr, r, opt, pr)
+	if err != nil {
+		return err
+	}
+	return c.waitOrCancel(ctx, op, pr, true)
+}
+
+// waitOrCancel waits for the operation and attempts to cancel it if the
+// context is cancelled. If clean is set, the uploaded archive is removed.
+func (c *Client) waitOrCancel(ctx context.Context, op *longrunning.Operation, pr *progress, clean bool) error {
+	err := c.wait(ctx, op, pr, clean)
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	cctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+	if cerr := op.Cancel(cctx); cerr == nil {
+		if clean {
+			c.deleteSource(cctx, op, true)
+		}
+		return fmt.Errorf("deploy cancelled: %v", err)
+	}
+	return fmt.Errorf("deploy interrupted, operation %s is still running: %v", op.Name(), err)
+}
+
+// StartDeploy is like Deploy, but returns the name of the operation
+// instead of waiting for it. See Wait.
+func (c *Client) StartDeploy(ctx context.Context, name string, tr Trigger, r io.Reader, opt *DeployOptions) (string, error) {
+	if opt == nil {
+		opt = &DeployOptions{}
+	}
+	op, err := c.startDeploy(ctx, name, tr, r, opt, newProgress(opt.Progress))
+	if err != nil {
+		return "", err
+	}
+	return op.Name(), nil
+}
+
+func (c *Client) startDeploy(ctx context.Context, name string, tr Trigger, r io.Reader, opt *DeployOptions, pr *progress) (*longrunning.Operation, error) {
+	if opt.Keep > 0 && opt.StagingBucket == "" {
+		return nil, fmt.Errorf("keeping archives requires a staging bucket")
+	}
+	if opt.Deployer == "" && !opt.NoMetadata {
+		o := *opt
+		o.Deployer = c.deployer(ctx)
+		opt = &o
+	}
+	f, err := c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{
+		Name: c.functionID(name),
+	})
+	create := false
+	if status.Code(err) == codes.NotFound {
+		f = &funcs.CloudFunction{
+			Name: c.functionID(name),
+		}
+		create = true
+	} else if err != nil {
+		return nil, err
+	}
+	if err = c.configure(f, tr, opt); err != nil {
+		return nil, err
+	}
+
+	cleanup := func() {}
+	if opt.StagingBucket != "" {
+		var url string
+		url, cleanup, err = c.uploadToBucket(ctx, name, f, r, opt, pr)
+		if err != nil {
+			return nil, err
+		}
+		f.SourceCode = &funcs.CloudFunction_SourceArchiveUrl{SourceArchiveUrl: url}
+	} else {
+		url, err := c.uploadToURL(ctx, r, pr)
+		if err != nil {
+			return nil, err
+		}
+		f.SourceCode = &funcs.CloudFunction_SourceUploadUrl{SourceUploadUrl: url}
+	}
+	op, err := c.submit(ctx, f, create, pr)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	return op, nil
+}
+
+// configure sets the trigger, runtime, environment, settings and labels of
+// the function from the options.
+func (c *Client) configure(f *funcs.CloudFunction, tr Trigger, opt *DeployOptions) error {
+	tr.setOn(c.project, f)
+	if IsNativeRuntime(opt.Runtime) {
+		f.Runtime = opt.Runtime
+		f.EntryPoint = NativeEntryPoint
+	} else {
+		if opt.Runtime != "" {
+			f.Runtime = opt.Runtime
+		} else if IsNativeRuntime(f.Runtime) {
+			// switching back to the shim, use the default runtime
+			f.Runtime = ""
+			delete(f.EnvironmentVariables, "CODE_LOCATION")
+		}
+		f.EntryPoint = nodeEntryPoint
+	}
+	if opt.Env != nil {
+		f.EnvironmentVariables = opt.Env
+	}
+	if IsNativeRuntime(f.Runtime) {
+		// builds are cached and zip archives are not built at all,
+		// check the settings that the function gets
+		if err := checkNativeEnv(f.EnvironmentVariables); err != nil {
+			return err
+		}
+	}
+	if err := opt.apply(f); err != nil {
+		return err
+	}
+	return opt.setLabels(f)
+}
+
+// updatePaths returns fields of the function that are set by Deploy and Rollback.
+// Other fields are left as is on update.
+func updatePaths(f *funcs.CloudFunction) []string {
+	paths := []string{
+		"entry_point", "runtime", "labels", "environment_variables",
+		"vpc_connector", "ingress_settings", "max_instances",
+		// restored by Rollback, unchanged by Deploy
+		"description", "timeout", "available_memory_mb", "service_account_email",
+		"min_instances", "vpc_connector_egress_settings", "build_environment_variables",
+	}
+	if f.GetSourceUploadUrl() != "" {
+		paths = append(paths, "source_upload_url")
+	} else {
+		paths = append(paths, "source_archive_url")
+	}
+	if f.GetEventTrigger() != nil {
+		paths = append(paths, "event_trigger")
+	} else {
+		paths = append(paths, "https_trigger")
+	}
+	return paths
+}
+
+// submit creates or updates the function.
+func (c *Client) submit(ctx context.Context, f *funcs.CloudFunction, create bool, pr *progress) (*longrunning.Operation, error) {
+	var (
+		oppb *longpb.Operation
+		err  error
+	)
+	if create {
+		pr.report(Progress{Phase: PhaseCreate})
+		oppb, err = c.funcs.CreateFunction(ctx, &funcs.CreateFunctionRequest{
+			Location: "projects/" + c.project + "/locations/" + c.region,
+			Function: f,
+		})
+	} else {
+		pr.report(Progress{Phase: PhaseUpdate})
+		oppb, err = c.funcs.UpdateFunction(ctx, &funcs.UpdateFunctionRequest{
+			Function:   f,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: updatePaths(f)},
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot update function: %v", err)
+	}
+	return longrunning.InternalNewOperation(c.long, oppb), nil
+}
+
+// archiveSize returns the size of the archive if the reader knows it.
+func archiveSize(r io.Reader) int64 {
+	if f, ok := r.(interface{ Stat() (os.FileInfo, error) }); ok {
+		if st, err := f.Stat(); err == nil {
+			return st.Size()
+		}
+	} else if b, ok := r.(interface{ Len() int }); ok {
+		return int64(b.Len())
+	}
+	return 0
+}
+
+// uploadToURL uploads the archive to a signed URL generated by the Functions API.
+func (c *Client) uploadToURL(ctx context.Context, r io.Reader, pr *progress) (string, error) {
+	resp, err := c.funcs.GenerateUploadUrl(ctx, &funcs.GenerateUploadUrlRequest{
+		Parent: "projects/" + c.project + "/locations/" + c.region,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot get upload url: %v", err)
+	}
+	total := archiveSize(r)
+	if total == 0 {
+		// the upload requires the content length
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		r, total = bytes.NewReader(data), int64(len(data))
+	}
+	pr.report(Progress{Phase: PhaseUpload, Total: total})
+	req, err := http.NewRequest("PUT", resp.UploadUrl, &progressReader{
+		r: r, total: total, pr: pr,
+	})
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = total
+	req.Header.Set("Content-Type", "application/zip")
+	req.Header.Set("X-Goog-Content-Length-Range", fmt.Sprintf("0,%d", MaxArchiveSize))
+	hresp, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return "", fmt.Errorf("upload failed: %v", err)
+	}
+	defer hresp.Body.Close()
+	if hresp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(hresp.Body, 1024))
+		return "", fmt.Errorf("upload failed: %s: %s", hresp.Status, bytes.TrimSpace(msg))
+	}
+	pr.report(Progress{Phase: PhaseUpload, Bytes: total, Total: total})
+	return resp.UploadUrl, nil
+}
+
+// progressReader reports the upload progress on each chunk of data.
+type progressReader struct {
+	r     io.Reader
+	n     int64
+	last  int64
+	total int64
+	pr    *progress
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.n-r.last >= uploadChunkSize {
+		r.last = r.n
+		r.pr.report(Progress{Phase: PhaseUpload, Bytes: r.n, Total: r.total})
+	}
+	return n, err
+}
+
+// uploadToBucket uploads the archive to the staging bucket. It returns the
+// URL of the object and a function that removes it.
+func (c *Client) uploadToBucket(ctx context.Context, name string, f *funcs.CloudFunction, r io.Reader, opt *DeployOptions, pr *progress) (string, func(), error) {
+	if err := c.getBucket(ctx, opt.StagingBucket, opt.StagingLocation); err != nil {
+		return "", nil, err
+	}
+	objName := fmt.Sprintf("%s-%d.zip", name, rand.Int())
+	meta := make(map[string]string)
+	id := ""
+	if opt.Keep > 0 {
+		now := time.Now()
+		id = now.UTC().Format(historyIDFormat)
+		objName = historyObject(name, id)
+		meta = historyMetadata(opt, now)
+		if err := c.writeHistorySettings(ctx, opt.StagingBucket, name, id, f); err != nil {
+			return "", nil, fmt.Errorf("cannot write function settings: %v", err)
+		}
+	}
+	// deleteSource only removes objects with the marker
+	meta[metaStaged] = "true"
+	obj := c.storage.Bucket(opt.StagingBucket).Object(objName)
+	// the object is deleted on failure, or after the operation completes
+	cleanup := func() {
+		cctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		defer cancel()
+		if id != "" {
+			c.deleteHistory(cctx, opt.StagingBucket, name, id)
+		} else {
+			obj.Delete(cctx)
+		}
+	}
+	total := archiveSize(r)
+	pr.report(Progress{Phase: PhaseUpload, Total: total})
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	w := obj.NewWriter(wctx)
+	w.ChunkSize = uploadChunkSize
+	w.ContentType = "application/zip"
+	w.Metadata = meta
+	w.ProgressFunc = func(n int64) {
+		pr.report(Progress{Phase: PhaseUpload, Bytes: n, Total: total})
+	}
+	n, err := io.Copy(w, r)
+	if err != nil {
+		cancel()
+		cleanup()
+		return "", nil, fmt.Errorf("upload failed: %v", err)
+	}
+	if err = w.Close(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("upload failed: %v", err)
+	}
+	pr.report(Progress{Phase: PhaseUpload, Bytes: n, Total: total})
+	return "gs://" + opt.StagingBucket + "/" + obj.ObjectName(), cleanup, nil
+}
+
+// Wait waits for a deploy operation started by StartDeploy to complete and
+// removes the uploaded archive.
+func (c *Client) Wait(ctx context.Context, operation string, progress func(p Progress)) error {
+	op := longrunning.InternalNewOperation(c.long, &longpb.Operation{Name: operation})
+	return c.wait(ctx, op, newProgress(progress), true)
+}
+
+func (c *Client) wait(ctx context.Context, op *longrunning.Operation, pr *progress, clean bool) error {
+	for !op.Done() {
+		pr.report(Progress{Phase: PhaseWait, Operation: op.Name()})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+		if err := op.Poll(ctx, nil); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if clean {
+				cctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+				c.deleteSource(cctx, op, true)
+				cancel()
+			}
+			return fmt.Errorf("deploy failed: %v", err)
+		}
+	}
+	if clean {
+		c.deleteSource(ctx, op, false)
+	}
+	pr.report(Progress{Phase: PhaseDone, Operation: op.Name()})
+	return nil
+}
+
+// deleteSource removes the archive uploaded to the staging bucket for the
+// operation, if any. Only objects marked as staged by Deploy are removed.
+// Kept archives are only removed if the deploy failed, otherwise older
+// archives of the function are pruned.
+func (c *Client) deleteSource(ctx context.Context, op *longrunning.Operation, failed bool) {
+	var meta funcs.OperationMetadataV1
+	if err := op.Metadata(&meta); err != nil || meta.Request == nil {
+		return
+	}
+	var f *funcs.CloudFunction
+	switch meta.Type {
+	case funcs.OperationType_CREATE_FUNCTION:
+		var req funcs.CreateFunctionRequest
+		if ptypes.UnmarshalAny(meta.Request, &req) == nil {
+			f = req.Function
+		}
+	case funcs.OperationType_UPDATE_FUNCTION:
+		var req funcs.UpdateFunctionRequest
+		if ptypes.UnmarshalAny(meta.Request, &req) == nil {
+			f = req.Function
+		}
+	}
+	if f == nil {
+		return
+	}
+	// only remove objects uploaded by Deploy
+	url := f.GetSourceArchiveUrl()
+	if !strings.HasPrefix(url, "gs://") {
+		return
+	}
+	i := strings.Index(url[5:], "/")
+	if i < 0 {
+		return
+	}
+	bucket, obj := url[5:5+i], url[5+i+1:]
+	h := c.storage.Bucket(bucket).Object(obj)
+	attrs, err := h.Attrs(ctx)
+	if err != nil || attrs.Metadata[metaStaged] != "true" {
+		return
+	}
+	fname := f.Name[strings.LastIndex(f.Name, "/")+1:]
+	if name, id, ok := parseHistoryObject(obj); ok && name == fname {
+		if failed {
+			c.deleteHistory(ctx, bucket, name, id)
+		} else if keep, err := strconv.Atoi(attrs.Metadata[metaKeep]); err == nil && keep > 0 {
+			c.pruneHistory(ctx, fname, bucket, keep)
+		}
+		return
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(obj, fname+"-"), ".zip")
+	if _, err := strconv.ParseUint(id, 10, 64); err != nil || id == obj {
+		return
+	}
+	h.Delete(ctx)
+}
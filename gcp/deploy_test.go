@@ -0,0 +1,198 @@
+package gcp_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/nwca/cloudfunc/gcp"
+	"github.com/nwca/cloudfunc/gcp/gcptest"
+)
+
+const testProject = "project"
+
+func newTestClient(t *testing.T) (*gcptest.Server, *gcp.Client) {
+	srv, err := gcptest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(srv.Close)
+	cli, err := gcp.NewClient(testProject, srv.ClientOptions()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	return srv, cli
+}
+
+func TestStartDeployWait(t *testing.T) {
+	srv, cli := newTestClient(t)
+	ctx := context.Background()
+	srv.SetOperationPolls(1)
+
+	archive := []byte("archive")
+	opt := &gcp.DeployOptions{Hash: "abc", Deployer: "ci@example.com"}
+	op, err := cli.StartDeploy(ctx, "hello", gcp.TopicTrigger{Topic: "events"}, bytes.NewReader(archive), opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var phases []gcp.Phase
+	err = cli.Wait(ctx, op, func(p gcp.Progress) {
+		if n := len(phases); n == 0 || phases[n-1] != p.Phase {
+			phases = append(phases, p.Phase)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(phases) != 2 || phases[0] != gcp.PhaseWait || phases[1] != gcp.PhaseDone {
+		t.Fatalf("unexpected phases: %v", phases)
+	}
+
+	name := "projects/" + testProject + "/locations/us-central1/functions/hello"
+	f := srv.Function(name)
+	if f == nil {
+		t.Fatal("function is not deployed")
+	}
+	if tr := f.GetEventTrigger(); tr == nil || tr.Resource != "projects/"+testProject+"/topics/events" {
+		t.Fatalf("unexpected trigger: %v", f.Trigger)
+	}
+	if data, ok := srv.Source(f); !ok || !bytes.Equal(data, archive) {
+		t.Fatalf("unexpected source: %q", data)
+	}
+	if h := f.Labels[gcp.HashLabel]; h != "abc" {
+		t.Fatalf("unexpected hash label: %q", h)
+	}
+	if d := f.Labels[gcp.DeployerLabel]; d != "ci-example-com" {
+		t.Fatalf("unexpected deployer label: %q", d)
+	}
+
+	list, err := cli.ListFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Name != name {
+		t.Fatalf("unexpected functions: %v", list)
+	}
+
+	if err = cli.DeleteFunc(ctx, "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Function(name) != nil {
+		t.Fatal("function is not deleted")
+	}
+	if list, err = cli.ListFuncs(); err != nil {
+		t.Fatal(err)
+	} else if len(list) != 0 {
+		t.Fatalf("unexpected functions: %v", list)
+	}
+}
+
+func TestDeployUpdate(t *testing.T) {
+	srv, cli := newTestClient(t)
+	ctx := context.Background()
+	tr := gcp.HTTPTrigger{}
+	name := "projects/" + testProject + "/locations/us-central1/functions/hello"
+
+	opt := &gcp.DeployOptions{
+		NoMetadata: true,
+		Runtime:    "go113",
+		Env:        map[string]string{"A": "1"},
+		Labels:     map[string]string{"team": "web"},
+	}
+	if err := cli.Deploy(ctx, "hello", tr, bytes.NewReader([]byte("v1")), opt); err != nil {
+		t.Fatal(err)
+	}
+	f := srv.Function(name)
+	if f == nil {
+		t.Fatal("function is not created")
+	}
+	if f.VersionId != 1 || f.EnvironmentVariables["A"] != "1" || f.Labels["team"] != "web" {
+		t.Fatalf("unexpected function: %v", f)
+	}
+	// fields that Deploy doesn't set must survive the update
+	f.KmsKeyName = "projects/p/locations/l/keyRings/r/cryptoKeys/k"
+	srv.SetFunction(f)
+
+	opt = &gcp.DeployOptions{
+		NoMetadata:    true,
+		Runtime:       "go113",
+		Env:           map[string]string{"B": "2"},
+		MaxInstances:  3,
+		VPCConnector:  "conn",
+		Ingress:       "internal-only",
+		StagingBucket: "staging",
+	}
+	if err := cli.Deploy(ctx, "hello", tr, bytes.NewReader([]byte("v2")), opt); err != nil {
+		t.Fatal(err)
+	}
+	f = srv.Function(name)
+	if f.VersionId != 2 {
+		t.Fatalf("expected version 2, got %d", f.VersionId)
+	}
+	if len(f.EnvironmentVariables) != 1 || f.EnvironmentVariables["B"] != "2" {
+		t.Fatalf("unexpected env: %v", f.EnvironmentVariables)
+	}
+	if f.Labels["team"] != "web" || f.MaxInstances != 3 || f.IngressSettings != gcp.IngressSettings["internal-only"] {
+		t.Fatalf("unexpected settings: %v", f)
+	}
+	if f.VpcConnector != "conn" {
+		t.Fatalf("unexpected vpc connector: %q", f.VpcConnector)
+	}
+	if f.KmsKeyName == "" {
+		t.Fatal("update cleared a field that is not in the update mask")
+	}
+	if f.GetSourceArchiveUrl() == "" {
+		t.Fatalf("unexpected source: %v", f.SourceCode)
+	}
+	// the staged archive is removed once the deploy completes
+	if objs := srv.Objects("staging"); len(objs) != 0 {
+		t.Fatalf("staged archives are not removed: %v", objs)
+	}
+
+	list, err := cli.ListFuncs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].VersionId != 2 {
+		t.Fatalf("unexpected functions: %v", list)
+	}
+	if err = cli.DeleteFunc(ctx, "hello"); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Function(name) != nil {
+		t.Fatal("function is not deleted")
+	}
+	if err = cli.DeleteFunc(ctx, "hello"); err == nil {
+		t.Fatal("expected an error deleting a missing function")
+	}
+}
+
+func TestDeployNativeAuth(t *testing.T) {
+	srv, cli := newTestClient(t)
+	ctx := context.Background()
+	tr := gcp.HTTPTrigger{}
+	name := "projects/" + testProject + "/locations/us-central1/functions/hello"
+
+	auth := map[string]string{"CLOUDFUNC_AUTH_AUDIENCE": "https://example.com"}
+	opt := &gcp.DeployOptions{NoMetadata: true, Runtime: "go113", Env: auth}
+	if err := cli.Deploy(ctx, "hello", tr, bytes.NewReader([]byte("v1")), opt); err == nil {
+		t.Fatal("expected an error")
+	}
+	if srv.Function(name) != nil {
+		t.Fatal("function is deployed without auth")
+	}
+
+	// settings kept from a shim deploy are rejected as well
+	opt = &gcp.DeployOptions{NoMetadata: true, Env: auth}
+	if err := cli.Deploy(ctx, "hello", tr, bytes.NewReader([]byte("v1")), opt); err != nil {
+		t.Fatal(err)
+	}
+	opt = &gcp.DeployOptions{NoMetadata: true, Runtime: "go113"}
+	if err := cli.Deploy(ctx, "hello", tr, bytes.NewReader([]byte("v2")), opt); err == nil {
+		t.Fatal("expected an error")
+	}
+	if f := srv.Function(name); f.VersionId != 1 {
+		t.Fatalf("expected version 1, got %d", f.VersionId)
+	}
+}
@@ -0,0 +1,230 @@
+package gcp
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+)
+
+// FunctionTrigger returns the trigger of a deployed function, mapping back the
+// event types and resources set by triggers of Deploy. Targets of returned
+// triggers are not set. Other triggers, including resources of other
+// projects, return an error.
+func FunctionTrigger(f *CloudFunction) (Trigger, error) {
+	if f.GetHttpsTrigger() != nil {
+		return HTTPTrigger{}, nil
+	}
+	t := f.GetEventTrigger()
+	if t == nil {
+		return nil, fmt.Errorf("function has no trigger")
+	}
+	proj := functionProject(f)
+	switch t.EventType {
+	case topicEventType:
+		if topic, ok := resourceName(t.Resource, proj, "topics"); ok {
+			return TopicTrigger{Topic: topic}, nil
+		}
+	case storageEventType:
+		if bucket, ok := resourceName(t.Resource, proj, "buckets"); ok {
+			return StorageTrigger{Bucket: bucket}, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported trigger %s on %s", t.EventType, t.Resource)
+}
+
+// functionProject returns the project from the full name of the function.
+func functionProject(f *CloudFunction) string {
+	parts := strings.Split(f.Name, "/")
+	if len(parts) < 2 || parts[0] != "projects" {
+		return ""
+	}
+	return parts[1]
+}
+
+// resourceName parses a resource in the form of projects/<proj>/<kind>/<name>.
+// Buckets are global, so their project may also be "_", as the API reports it.
+func resourceName(res, proj, kind string) (string, bool) {
+	parts := strings.Split(res, "/")
+	if len(parts) != 4 || parts[0] != "projects" || parts[2] != kind || parts[3] == "" {
+		return "", false
+	}
+	if parts[1] != proj && !(kind == "buckets" && parts[1] == "_") {
+		return "", false
+	}
+	return parts[3], true
+}
+
+// FunctionEnv returns environment variables of a deployed function: the ones
+// set on the function and, for functions started by the node shim, the ones
+// written to env.js of its archive. Variables set by Deploy itself are omitted.
+func (c *Client) FunctionEnv(ctx context.Context, f *CloudFunction) (map[string]string, error) {
+	env := make(map[string]string, len(f.EnvironmentVariables))
+	for k, v := range f.EnvironmentVariables {
+		env[k] = v
+	}
+	if IsNativeRuntime(f.Runtime) {
+		if env["CODE_LOCATION"] == nativeCodeLocation {
+			delete(env, "CODE_LOCATION")
+		}
+		return env, nil
+	}
+	data, err := c.downloadSource(ctx, f.Name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot download archive: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read archive: %v", err)
+	}
+	for _, zf := range zr.File {
+		if zf.Name != "env.js" {
+			continue
+		}
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, err
+		}
+		jsEnv, err := parseEnvJS(rc)
+		rc.Close()
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse env.js: %v", err)
+		}
+		for k, v := range jsEnv {
+			env[k] = v
+		}
+	}
+	return env, nil
+}
+
+// downloadSource downloads the archive of the function.
+func (c *Client) downloadSource(ctx context.Context, name string) ([]byte, error) {
+	resp, err := c.funcs.GenerateDownloadUrl(ctx, &funcs.GenerateDownloadUrlRequest{
+		Name: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot get download url: %v", err)
+	}
+	req, err := http.NewRequest("GET", resp.DownloadUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+	hresp, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer hresp.Body.Close()
+	if hresp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download failed: %s", hresp.Status)
+	}
+	return ioutil.ReadAll(io.LimitReader(hresp.Body, MaxArchiveSize))
+}
+
+// parseEnvJS reads variables from a script written by writeEnvJS.
+func parseEnvJS(r io.Reader) (map[string]string, error) {
+	const prefix = "process.env["
+	env := make(map[string]string)
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		k, v, ok := parseEnvJSLine(line, prefix)
+		if !ok {
+			return nil, fmt.Errorf("line %d: unexpected statement", n)
+		}
+		env[k] = v
+	}
+	return env, sc.Err()
+}
+
+// parseEnvJSLine parses a statement in the form of process.env["key"] = "value";
+func parseEnvJSLine(line, prefix string) (key, val string, _ bool) {
+	if !strings.HasPrefix(line, prefix) {
+		return "", "", false
+	}
+	line = line[len(prefix):]
+	q, err := strconv.QuotedPrefix(line)
+	if err != nil {
+		return "", "", false
+	}
+	key, _ = strconv.Unquote(q)
+	line = strings.TrimPrefix(line[len(q):], "] = ")
+	if q, err = strconv.QuotedPrefix(line); err != nil || line[len(q):] != ";" {
+		return "", "", false
+	}
+	val, _ = strconv.Unquote(q)
+	return key, val, true
+}
+
+// UnmappedSettings returns settings of a deployed function that Deploy can't
+// set, as "setting: value" strings. Settings that are equal to their defaults
+// are omitted.
+func UnmappedSettings(f *CloudFunction) []string {
+	var out []string
+	add := func(name, val string) {
+		if val != "" {
+			out = append(out, name+": "+val)
+		}
+	}
+	if parts := strings.Split(f.Name, "/"); len(parts) > 3 && parts[3] != region {
+		add("region", parts[3])
+	}
+	add("description", f.Description)
+	if rt := f.Runtime; !IsNativeRuntime(rt) && rt != "" && !strings.HasPrefix(rt, "nodejs") {
+		add("runtime", rt)
+	}
+	if f.EntryPoint != nodeEntryPoint && f.EntryPoint != NativeEntryPoint {
+		add("entry point", f.EntryPoint)
+	}
+	if sa := f.ServiceAccountEmail; sa != functionProject(f)+"@appspot.gserviceaccount.com" {
+		add("service account", sa)
+	}
+	if f.MinInstances != 0 {
+		add("min instances", strconv.Itoa(int(f.MinInstances)))
+	}
+	if e := f.VpcConnectorEgressSettings; e == funcs.CloudFunction_ALL_TRAFFIC {
+		add("vpc connector egress", e.String())
+	}
+	add("network", f.Network)
+	add("build environment variables", sortedKeys(f.BuildEnvironmentVariables))
+	secrets := make([]string, 0, len(f.SecretEnvironmentVariables))
+	for _, s := range f.SecretEnvironmentVariables {
+		secrets = append(secrets, s.Key)
+	}
+	add("secret environment variables", strings.Join(secrets, ", "))
+	volumes := make([]string, 0, len(f.SecretVolumes))
+	for _, s := range f.SecretVolumes {
+		volumes = append(volumes, s.MountPath)
+	}
+	add("secret volumes", strings.Join(volumes, ", "))
+	add("kms key", f.KmsKeyName)
+	add("build worker pool", f.BuildWorkerPool)
+	add("docker repository", f.DockerRepository)
+	if r := f.GetSourceRepository(); r != nil {
+		add("source repository", r.Url)
+	}
+	if f.GetEventTrigger().GetFailurePolicy() != nil {
+		add("failure policy", "retry")
+	}
+	return out
+}
+
+func sortedKeys(m map[string]string) string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ", ")
+}
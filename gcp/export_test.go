@@ -0,0 +1,38 @@
+package gcp
+
+import (
+	"testing"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+)
+
+func TestFunctionTrigger(t *testing.T) {
+	const name = "projects/p/locations/us-central1/functions/f"
+	cases := []struct {
+		event, res string
+		exp        Trigger
+	}{
+		{topicEventType, "projects/p/topics/t", TopicTrigger{Topic: "t"}},
+		{topicEventType, "projects/q/topics/t", nil},
+		{topicEventType, "projects/_/topics/t", nil},
+		{storageEventType, "projects/p/buckets/b", StorageTrigger{Bucket: "b"}},
+		{storageEventType, "projects/_/buckets/b", StorageTrigger{Bucket: "b"}},
+		{storageEventType, "projects/q/buckets/b", nil},
+		{storageEventType, "projects/_/buckets/", nil},
+	}
+	for _, c := range cases {
+		f := &CloudFunction{Name: name, Trigger: &funcs.CloudFunction_EventTrigger{
+			EventTrigger: &funcs.EventTrigger{EventType: c.event, Resource: c.res},
+		}}
+		tr, err := FunctionTrigger(f)
+		if c.exp == nil {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %v", c.res, tr)
+			}
+		} else if err != nil {
+			t.Errorf("%s: %v", c.res, err)
+		} else if tr != c.exp {
+			t.Errorf("%s: expected %v, got %v", c.res, c.exp, tr)
+		}
+	}
+}
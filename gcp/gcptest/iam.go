@@ -0,0 +1,69 @@
+package gcptest
+
+import (
+	"context"
+	"strconv"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Policy returns the IAM policy of a function by its full resource name, or
+// nil if the policy was never set.
+func (s *Server) Policy(name string) *iampb.Policy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p := s.policies[name]
+	if p == nil {
+		return nil
+	}
+	return proto.Clone(p).(*iampb.Policy)
+}
+
+// policy returns the policy of an existing function, with an etag that
+// changes on each update.
+func (s *Server) policy(name string) (*iampb.Policy, error) {
+	if s.funcs[name] == nil {
+		return nil, status.Errorf(codes.NotFound, "function %s not found", name)
+	}
+	p := s.policies[name]
+	if p == nil {
+		p = &iampb.Policy{Version: 1, Etag: []byte("0")}
+		s.policies[name] = p
+	}
+	return p, nil
+}
+
+func (fs *funcsServer) GetIamPolicy(ctx context.Context, req *iampb.GetIamPolicyRequest) (*iampb.Policy, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, err := s.policy(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Clone(p).(*iampb.Policy), nil
+}
+
+func (fs *funcsServer) SetIamPolicy(ctx context.Context, req *iampb.SetIamPolicyRequest) (*iampb.Policy, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cur, err := s.policy(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if req.Policy == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "policy not set")
+	}
+	if len(req.Policy.Etag) != 0 && string(req.Policy.Etag) != string(cur.Etag) {
+		return nil, status.Errorf(codes.Aborted, "policy of %s was changed concurrently", req.Resource)
+	}
+	n, _ := strconv.Atoi(string(cur.Etag))
+	p := proto.Clone(req.Policy).(*iampb.Policy)
+	p.Etag = []byte(strconv.Itoa(n + 1))
+	s.policies[req.Resource] = p
+	return proto.Clone(p).(*iampb.Policy), nil
+}
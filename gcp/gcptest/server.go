@@ -0,0 +1,492 @@
+// Package gcptest provides in-memory fakes of the Cloud Functions and Cloud
+// Storage APIs for testing code that uses gcp.Client.
+//
+//	srv, err := gcptest.NewServer()
+//	...
+//	defer srv.Close()
+//	cli, err := gcp.NewClient("project", srv.ClientOptions()...)
+package gcptest
+
+import (
+	"context"
+	"net"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/nwca/cloudfunc/gcp"
+	"google.golang.org/api/option"
+	longpb "google.golang.org/genproto/googleapis/longrunning"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Server is a fake of the Cloud Functions gRPC API, its operations and the
+// Cloud Storage JSON API. Deployed functions and uploaded archives are kept
+// in memory.
+type Server struct {
+	// URL of the HTTP server that serves storage and upload URLs.
+	URL string
+	// Addr is the address of the gRPC server.
+	Addr string
+
+	grpc *grpc.Server
+	http *httptest.Server
+
+	mu      sync.Mutex
+	funcs   map[string]*funcs.CloudFunction
+	ops     map[string]*operation
+	uploads map[string][]byte
+	buckets map[string]*bucket
+	// archives served by URLs returned by GenerateDownloadUrl
+	downloads map[string][]byte
+	// IAM policies of functions
+	policies map[string]*iampb.Policy
+	// resumable storage uploads in progress
+	resumable map[string]*resumable
+	lastID    int
+	polls     int
+	fail      error
+}
+
+// operation is a fake long-running operation.
+type operation struct {
+	op *longpb.Operation
+	// polls left before the operation completes
+	polls int
+	// finish applies the changes and returns the response of the operation.
+	finish func() (proto.Message, error)
+}
+
+// NewServer starts the fake servers on the loopback interface.
+func NewServer() (*Server, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Addr:    lis.Addr().String(),
+		grpc:    grpc.NewServer(),
+		funcs:   make(map[string]*funcs.CloudFunction),
+		ops:     make(map[string]*operation),
+		uploads: make(map[string][]byte),
+		buckets: make(map[string]*bucket),
+
+		downloads: make(map[string][]byte),
+		policies:  make(map[string]*iampb.Policy),
+		resumable: make(map[string]*resumable),
+	}
+	funcs.RegisterCloudFunctionsServiceServer(s.grpc, &funcsServer{s: s})
+	longpb.RegisterOperationsServer(s.grpc, &opsServer{s})
+	go s.grpc.Serve(lis)
+	s.http = httptest.NewServer(s.httpHandler())
+	s.URL = s.http.URL
+	return s, nil
+}
+
+// Close stops the servers.
+func (s *Server) Close() {
+	s.grpc.Stop()
+	s.http.Close()
+}
+
+// ClientOptions returns options for gcp.NewClient that connect it to the server.
+func (s *Server) ClientOptions() []gcp.ClientOption {
+	return []gcp.ClientOption{
+		gcp.WithFunctionsOptions(
+			option.WithEndpoint(s.Addr),
+			option.WithoutAuthentication(),
+			option.WithGRPCDialOption(grpc.WithInsecure()),
+		),
+		gcp.WithStorageOptions(
+			option.WithEndpoint(s.URL+storagePath),
+			option.WithoutAuthentication(),
+		),
+		gcp.WithHTTPClient(s.http.Client()),
+	}
+}
+
+// SetOperationPolls sets the number of polls before new operations complete.
+func (s *Server) SetOperationPolls(n int) {
+	s.mu.Lock()
+	s.polls = n
+	s.mu.Unlock()
+}
+
+// FailNextOperation makes the next create, update or delete operation fail
+// with a given error.
+func (s *Server) FailNextOperation(err error) {
+	s.mu.Lock()
+	s.fail = err
+	s.mu.Unlock()
+}
+
+// SetFunction adds or replaces a deployed function. The name must be a full
+// resource name: projects/<project>/locations/<region>/functions/<name>.
+func (s *Server) SetFunction(f *funcs.CloudFunction) {
+	s.mu.Lock()
+	s.funcs[f.Name] = proto.Clone(f).(*funcs.CloudFunction)
+	s.mu.Unlock()
+}
+
+// Function returns a deployed function by its full resource name, or nil.
+func (s *Server) Function(name string) *funcs.CloudFunction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.funcs[name]
+	if f == nil {
+		return nil
+	}
+	return proto.Clone(f).(*funcs.CloudFunction)
+}
+
+// Source returns the archive of the function source, uploaded either to
+// a signed URL or to a storage bucket.
+func (s *Server) Source(f *funcs.CloudFunction) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.source(f)
+}
+
+func (s *Server) source(f *funcs.CloudFunction) ([]byte, bool) {
+	if url := f.GetSourceUploadUrl(); url != "" {
+		data, ok := s.uploads[url]
+		return data, ok
+	}
+	url := f.GetSourceArchiveUrl()
+	if !strings.HasPrefix(url, "gs://") {
+		return nil, false
+	}
+	i := strings.Index(url[5:], "/")
+	if i < 0 {
+		return nil, false
+	}
+	b := s.buckets[url[5:5+i]]
+	if b == nil {
+		return nil, false
+	}
+	o := b.objects[url[5+i+1:]]
+	if o == nil {
+		return nil, false
+	}
+	return o.data, true
+}
+
+func (s *Server) nextID() string {
+	s.lastID++
+	return strconv.Itoa(s.lastID)
+}
+
+// startOperation registers an operation for the request. The finish function
+// is called once the operation completes.
+func (s *Server) startOperation(typ funcs.OperationType, target string, req proto.Message, finish func() (proto.Message, error)) (*longpb.Operation, error) {
+	anyReq, err := ptypes.MarshalAny(req)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := ptypes.MarshalAny(&funcs.OperationMetadataV1{
+		Target: target, Type: typ, Request: anyReq,
+	})
+	if err != nil {
+		return nil, err
+	}
+	op := &operation{
+		op: &longpb.Operation{
+			Name:     "operations/" + s.nextID(),
+			Metadata: meta,
+		},
+		polls:  s.polls,
+		finish: finish,
+	}
+	if s.fail != nil {
+		err := s.fail
+		s.fail = nil
+		op.finish = func() (proto.Message, error) { return nil, err }
+	}
+	s.ops[op.op.Name] = op
+	if op.polls == 0 {
+		op.complete()
+	}
+	return proto.Clone(op.op).(*longpb.Operation), nil
+}
+
+// complete finishes the operation and records its result.
+func (op *operation) complete() {
+	op.op.Done = true
+	resp, err := op.finish()
+	if err != nil {
+		st, _ := status.FromError(err)
+		op.op.Result = &longpb.Operation_Error{Error: st.Proto()}
+		return
+	}
+	if resp == nil {
+		resp = &empty.Empty{}
+	}
+	anyResp, err := ptypes.MarshalAny(resp)
+	if err != nil {
+		op.op.Result = &longpb.Operation_Error{Error: &spb.Status{
+			Code: int32(codes.Internal), Message: err.Error(),
+		}}
+		return
+	}
+	op.op.Result = &longpb.Operation_Response{Response: anyResp}
+}
+
+type funcsServer struct {
+	funcs.UnimplementedCloudFunctionsServiceServer
+	s *Server
+}
+
+func (fs *funcsServer) ListFunctions(ctx context.Context, req *funcs.ListFunctionsRequest) (*funcs.ListFunctionsResponse, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	prefix := req.Parent + "/functions/"
+	if strings.HasSuffix(req.Parent, "/locations/-") {
+		prefix = strings.TrimSuffix(req.Parent, "-")
+	}
+	resp := &funcs.ListFunctionsResponse{}
+	for name, f := range s.funcs {
+		if strings.HasPrefix(name, prefix) {
+			resp.Functions = append(resp.Functions, proto.Clone(f).(*funcs.CloudFunction))
+		}
+	}
+	sort.Slice(resp.Functions, func(i, j int) bool {
+		return resp.Functions[i].Name < resp.Functions[j].Name
+	})
+	return resp, nil
+}
+
+func (fs *funcsServer) GetFunction(ctx context.Context, req *funcs.GetFunctionRequest) (*funcs.CloudFunction, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.funcs[req.Name]
+	if f == nil {
+		return nil, status.Errorf(codes.NotFound, "function %s not found", req.Name)
+	}
+	return proto.Clone(f).(*funcs.CloudFunction), nil
+}
+
+// deploy validates the function and returns the deployed version.
+func (s *Server) deploy(f *funcs.CloudFunction, prev *funcs.CloudFunction) (*funcs.CloudFunction, error) {
+	if f.Trigger == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "function %s has no trigger", f.Name)
+	}
+	if _, ok := s.source(f); !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "source of function %s not found", f.Name)
+	}
+	f = proto.Clone(f).(*funcs.CloudFunction)
+	f.Status = funcs.CloudFunctionStatus_ACTIVE
+	f.UpdateTime = ptypes.TimestampNow()
+	f.VersionId = 1
+	if prev != nil {
+		f.VersionId = prev.VersionId + 1
+	}
+	return f, nil
+}
+
+func (fs *funcsServer) CreateFunction(ctx context.Context, req *funcs.CreateFunctionRequest) (*longpb.Operation, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := req.Function
+	if f == nil || !strings.HasPrefix(f.Name, req.Location+"/functions/") {
+		return nil, status.Errorf(codes.InvalidArgument, "function name must be in %s", req.Location)
+	}
+	return s.startOperation(funcs.OperationType_CREATE_FUNCTION, f.Name, req, func() (proto.Message, error) {
+		if s.funcs[f.Name] != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "function %s already exists", f.Name)
+		}
+		nf, err := s.deploy(f, nil)
+		if err != nil {
+			return nil, err
+		}
+		s.funcs[f.Name] = nf
+		return nf, nil
+	})
+}
+
+func (fs *funcsServer) UpdateFunction(ctx context.Context, req *funcs.UpdateFunctionRequest) (*longpb.Operation, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.Function == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "function not set")
+	}
+	name := req.Function.Name
+	if s.funcs[name] == nil {
+		return nil, status.Errorf(codes.NotFound, "function %s not found", name)
+	}
+	if len(req.UpdateMask.GetPaths()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	f := proto.Clone(s.funcs[name]).(*funcs.CloudFunction)
+	if err := applyMask(f, req.Function, req.UpdateMask.Paths); err != nil {
+		return nil, err
+	}
+	return s.startOperation(funcs.OperationType_UPDATE_FUNCTION, name, req, func() (proto.Message, error) {
+		prev := s.funcs[name]
+		if prev == nil {
+			return nil, status.Errorf(codes.NotFound, "function %s not found", name)
+		}
+		nf, err := s.deploy(f, prev)
+		if err != nil {
+			return nil, err
+		}
+		s.funcs[name] = nf
+		return nf, nil
+	})
+}
+
+// applyMask copies fields listed in the update mask from src to dst.
+func applyMask(dst, src *funcs.CloudFunction, paths []string) error {
+	d, sm := dst.ProtoReflect(), src.ProtoReflect()
+	fields := d.Descriptor().Fields()
+	for _, p := range paths {
+		fd := fields.ByName(protoreflect.Name(p))
+		if fd == nil || p == "name" {
+			return status.Errorf(codes.InvalidArgument, "invalid field in update mask: %q", p)
+		}
+		if sm.Has(fd) {
+			d.Set(fd, sm.Get(fd))
+		} else {
+			d.Clear(fd)
+		}
+	}
+	return nil
+}
+
+func (fs *funcsServer) DeleteFunction(ctx context.Context, req *funcs.DeleteFunctionRequest) (*longpb.Operation, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.funcs[req.Name] == nil {
+		return nil, status.Errorf(codes.NotFound, "function %s not found", req.Name)
+	}
+	return s.startOperation(funcs.OperationType_DELETE_FUNCTION, req.Name, req, func() (proto.Message, error) {
+		delete(s.funcs, req.Name)
+		delete(s.policies, req.Name)
+		return nil, nil
+	})
+}
+
+func (fs *funcsServer) CallFunction(ctx context.Context, req *funcs.CallFunctionRequest) (*funcs.CallFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "CallFunction is not supported by the fake")
+}
+
+func (fs *funcsServer) GenerateUploadUrl(ctx context.Context, req *funcs.GenerateUploadUrlRequest) (*funcs.GenerateUploadUrlResponse, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !strings.HasPrefix(req.Parent, "projects/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent %q", req.Parent)
+	}
+	url := s.URL + uploadURLPath + s.nextID()
+	s.uploads[url] = nil
+	return &funcs.GenerateUploadUrlResponse{UploadUrl: url}, nil
+}
+
+func (fs *funcsServer) GenerateDownloadUrl(ctx context.Context, req *funcs.GenerateDownloadUrlRequest) (*funcs.GenerateDownloadUrlResponse, error) {
+	s := fs.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f := s.funcs[req.Name]
+	if f == nil {
+		return nil, status.Errorf(codes.NotFound, "function %s not found", req.Name)
+	}
+	data, ok := s.source(f)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "function %s has no source", req.Name)
+	}
+	url := s.URL + downloadURLPath + s.nextID()
+	s.downloads[url] = data
+	return &funcs.GenerateDownloadUrlResponse{DownloadUrl: url}, nil
+}
+
+type opsServer struct {
+	s *Server
+}
+
+func (ops *opsServer) ListOperations(ctx context.Context, req *longpb.ListOperationsRequest) (*longpb.ListOperationsResponse, error) {
+	s := ops.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resp := &longpb.ListOperationsResponse{}
+	for _, op := range s.ops {
+		resp.Operations = append(resp.Operations, proto.Clone(op.op).(*longpb.Operation))
+	}
+	sort.Slice(resp.Operations, func(i, j int) bool {
+		return resp.Operations[i].Name < resp.Operations[j].Name
+	})
+	return resp, nil
+}
+
+func (ops *opsServer) GetOperation(ctx context.Context, req *longpb.GetOperationRequest) (*longpb.Operation, error) {
+	s := ops.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op := s.ops[req.Name]
+	if op == nil {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", req.Name)
+	}
+	if !op.op.Done {
+		op.polls--
+		if op.polls <= 0 {
+			op.complete()
+		}
+	}
+	return proto.Clone(op.op).(*longpb.Operation), nil
+}
+
+func (ops *opsServer) DeleteOperation(ctx context.Context, req *longpb.DeleteOperationRequest) (*empty.Empty, error) {
+	s := ops.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.ops[req.Name] == nil {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", req.Name)
+	}
+	delete(s.ops, req.Name)
+	return &empty.Empty{}, nil
+}
+
+func (ops *opsServer) CancelOperation(ctx context.Context, req *longpb.CancelOperationRequest) (*empty.Empty, error) {
+	s := ops.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op := s.ops[req.Name]
+	if op == nil {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", req.Name)
+	}
+	if !op.op.Done {
+		op.op.Done = true
+		op.op.Result = &longpb.Operation_Error{Error: &spb.Status{
+			Code: int32(codes.Canceled), Message: "operation cancelled",
+		}}
+	}
+	return &empty.Empty{}, nil
+}
+
+func (ops *opsServer) WaitOperation(ctx context.Context, req *longpb.WaitOperationRequest) (*longpb.Operation, error) {
+	s := ops.s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	op := s.ops[req.Name]
+	if op == nil {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", req.Name)
+	}
+	if !op.op.Done {
+		op.complete()
+	}
+	return proto.Clone(op.op).(*longpb.Operation), nil
+}
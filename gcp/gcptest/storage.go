@@ -0,0 +1,457 @@
+package gcptest
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// storagePath is the base path of the storage JSON API.
+	storagePath = "/storage/v1/"
+	// mediaPath is the base path of storage uploads.
+	mediaPath = "/upload/storage/v1/"
+	// resumablePath is the path of resumable storage uploads.
+	resumablePath = "/resumable/"
+	// uploadURLPath is the path of URLs returned by GenerateUploadUrl.
+	uploadURLPath = "/upload-url/"
+	// downloadURLPath is the path of URLs returned by GenerateDownloadUrl.
+	downloadURLPath = "/download-url/"
+)
+
+// bucket is a fake storage bucket.
+type bucket struct {
+	name     string
+	location string
+	class    string
+	created  time.Time
+	objects  map[string]*object
+}
+
+// object is a fake storage object.
+type object struct {
+	name        string
+	contentType string
+	metadata    map[string]string
+	data        []byte
+	created     time.Time
+}
+
+// resumable is a resumable upload in progress.
+type resumable struct {
+	bucket string
+	obj    *object
+}
+
+type bucketJSON struct {
+	Kind         string    `json:"kind"`
+	Name         string    `json:"name"`
+	Location     string    `json:"location,omitempty"`
+	StorageClass string    `json:"storageClass,omitempty"`
+	TimeCreated  time.Time `json:"timeCreated"`
+}
+
+type objectJSON struct {
+	Kind        string            `json:"kind"`
+	Bucket      string            `json:"bucket"`
+	Name        string            `json:"name"`
+	ContentType string            `json:"contentType,omitempty"`
+	Size        string            `json:"size"`
+	MD5Hash     string            `json:"md5Hash"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+	TimeCreated time.Time         `json:"timeCreated"`
+	Updated     time.Time         `json:"updated"`
+}
+
+func (b *bucket) json() bucketJSON {
+	return bucketJSON{
+		Kind: "storage#bucket", Name: b.name,
+		Location: b.location, StorageClass: b.class, TimeCreated: b.created,
+	}
+}
+
+func (o *object) json(bucket string) objectJSON {
+	sum := md5.Sum(o.data)
+	return objectJSON{
+		Kind: "storage#object", Bucket: bucket, Name: o.name,
+		ContentType: o.contentType, Metadata: o.metadata,
+		Size:        strconv.Itoa(len(o.data)),
+		MD5Hash:     base64.StdEncoding.EncodeToString(sum[:]),
+		TimeCreated: o.created, Updated: o.created,
+	}
+}
+
+// Objects returns names of objects in the bucket.
+func (s *Server) Objects(bucket string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		return nil
+	}
+	var out []string
+	for name := range b.objects {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Object returns the content of the object and its metadata.
+func (s *Server) Object(bucket, name string) ([]byte, map[string]string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	b := s.buckets[bucket]
+	if b == nil {
+		return nil, nil, false
+	}
+	o := b.objects[name]
+	if o == nil {
+		return nil, nil, false
+	}
+	return o.data, o.metadata, true
+}
+
+func (s *Server) httpHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(storagePath, s.serveStorage)
+	mux.HandleFunc(mediaPath, s.serveStorage)
+	mux.HandleFunc(resumablePath, s.serveResumable)
+	mux.HandleFunc(uploadURLPath, s.serveUploadURL)
+	mux.HandleFunc(downloadURLPath, s.serveDownloadURL)
+	mux.HandleFunc("/", s.serveObjectData)
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	var resp struct {
+		Error struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	resp.Error.Code, resp.Error.Message = code, msg
+	writeJSON(w, code, resp)
+}
+
+// serveUploadURL accepts archives uploaded to URLs returned by GenerateUploadUrl.
+func (s *Server) serveUploadURL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "PUT" {
+		writeError(w, http.StatusMethodNotAllowed, "expected PUT")
+		return
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "application/zip" {
+		writeError(w, http.StatusBadRequest, "unexpected content type "+ct)
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	url := s.URL + r.URL.Path
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.uploads[url]; !ok {
+		writeError(w, http.StatusForbidden, "unknown upload url")
+		return
+	}
+	s.uploads[url] = data
+	w.WriteHeader(http.StatusOK)
+}
+
+// serveDownloadURL serves archives from URLs returned by GenerateDownloadUrl.
+func (s *Server) serveDownloadURL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "expected GET")
+		return
+	}
+	s.mu.Lock()
+	data, ok := s.downloads[s.URL+r.URL.Path]
+	s.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusForbidden, "unknown download url")
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	w.Write(data)
+}
+
+// serveObjectData serves contents of objects read by storage readers,
+// at /<bucket>/<object>.
+func (s *Server) serveObjectData(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeError(w, http.StatusMethodNotAllowed, "expected GET")
+		return
+	}
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	if len(parts) != 2 {
+		writeError(w, http.StatusNotFound, "unsupported request "+r.Method+" "+r.URL.Path)
+		return
+	}
+	s.mu.Lock()
+	var o *object
+	if b := s.buckets[parts[0]]; b != nil {
+		o = b.objects[parts[1]]
+	}
+	s.mu.Unlock()
+	if o == nil {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	w.Header().Set("Content-Type", o.contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(o.data)))
+	w.Write(o.data)
+}
+
+// serveStorage serves a subset of the storage JSON API used by gcp.Client.
+func (s *Server) serveStorage(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.EscapedPath()
+	upload := strings.HasPrefix(path, mediaPath)
+	if upload {
+		path = strings.TrimPrefix(path, mediaPath)
+	} else {
+		path = strings.TrimPrefix(path, storagePath)
+	}
+	parts := strings.SplitN(path, "/", 4)
+	for i, p := range parts {
+		parts[i], _ = url.PathUnescape(p)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case len(parts) == 1 && parts[0] == "b" && r.Method == "POST":
+		s.createBucket(w, r)
+	case len(parts) == 2 && parts[0] == "b" && r.Method == "GET":
+		b := s.buckets[parts[1]]
+		if b == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		writeJSON(w, http.StatusOK, b.json())
+	case len(parts) == 3 && parts[0] == "b" && parts[2] == "o":
+		b := s.buckets[parts[1]]
+		if b == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		switch r.Method {
+		case "GET":
+			s.listObjects(w, r, b)
+		case "POST":
+			s.insertObject(w, r, b)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "unsupported method")
+		}
+	case len(parts) == 4 && parts[0] == "b" && parts[2] == "o" && !upload:
+		b := s.buckets[parts[1]]
+		if b == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		o := b.objects[parts[3]]
+		if o == nil {
+			writeError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		switch r.Method {
+		case "GET":
+			if r.URL.Query().Get("alt") == "media" {
+				w.Header().Set("Content-Type", o.contentType)
+				w.Write(o.data)
+				return
+			}
+			writeJSON(w, http.StatusOK, o.json(b.name))
+		case "DELETE":
+			delete(b.objects, o.name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, "unsupported method")
+		}
+	default:
+		writeError(w, http.StatusNotFound, "unsupported request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func (s *Server) createBucket(w http.ResponseWriter, r *http.Request) {
+	var req bucketJSON
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Name == "" {
+		writeError(w, http.StatusBadRequest, "bucket name is required")
+		return
+	} else if s.buckets[req.Name] != nil {
+		writeError(w, http.StatusConflict, "bucket already exists")
+		return
+	}
+	b := &bucket{
+		name: req.Name, location: req.Location, class: req.StorageClass,
+		created: time.Now().UTC(), objects: make(map[string]*object),
+	}
+	s.buckets[b.name] = b
+	writeJSON(w, http.StatusOK, b.json())
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, b *bucket) {
+	prefix := r.URL.Query().Get("prefix")
+	var names []string
+	for name := range b.objects {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var resp struct {
+		Kind  string       `json:"kind"`
+		Items []objectJSON `json:"items"`
+	}
+	resp.Kind = "storage#objects"
+	for _, name := range names {
+		resp.Items = append(resp.Items, b.objects[name].json(b.name))
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// readObjectMeta reads object metadata sent with an upload.
+func readObjectMeta(r io.Reader, q url.Values) (*object, error) {
+	var meta objectJSON
+	if r != nil {
+		if err := json.NewDecoder(r).Decode(&meta); err != nil && err != io.EOF {
+			return nil, err
+		}
+	}
+	o := &object{
+		name: meta.Name, contentType: meta.ContentType, metadata: meta.Metadata,
+		created: time.Now().UTC(),
+	}
+	if o.name == "" {
+		o.name = q.Get("name")
+	}
+	if o.name == "" {
+		return nil, fmt.Errorf("object name is required")
+	}
+	return o, nil
+}
+
+func (s *Server) insertObject(w http.ResponseWriter, r *http.Request, b *bucket) {
+	q := r.URL.Query()
+	switch q.Get("uploadType") {
+	case "media":
+		o, err := readObjectMeta(nil, q)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		o.contentType = r.Header.Get("Content-Type")
+		if o.data, err = ioutil.ReadAll(r.Body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		b.objects[o.name] = o
+		writeJSON(w, http.StatusOK, o.json(b.name))
+	case "multipart":
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		part, err := mr.NextPart()
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		o, err := readObjectMeta(part, q)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if part, err = mr.NextPart(); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if o.contentType == "" {
+			o.contentType = part.Header.Get("Content-Type")
+		}
+		if o.data, err = ioutil.ReadAll(part); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		b.objects[o.name] = o
+		writeJSON(w, http.StatusOK, o.json(b.name))
+	case "resumable":
+		o, err := readObjectMeta(r.Body, q)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		id := s.nextID()
+		s.resumable[id] = &resumable{bucket: b.name, obj: o}
+		w.Header().Set("Location", s.URL+resumablePath+id)
+		w.WriteHeader(http.StatusOK)
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported upload type")
+	}
+}
+
+// serveResumable accepts chunks of a resumable upload.
+func (s *Server) serveResumable(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, resumablePath)
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	up := s.resumable[id]
+	if up == nil || r.Method != "PUT" {
+		writeError(w, http.StatusNotFound, "unknown upload")
+		return
+	}
+	// Content-Range is "bytes first-last/total", with * for unknown parts
+	total := int64(-1)
+	if cr := r.Header.Get("Content-Range"); cr != "" {
+		i := strings.LastIndex(cr, "/")
+		if i >= 0 && cr[i+1:] != "*" {
+			total, _ = strconv.ParseInt(cr[i+1:], 10, 64)
+		}
+	} else {
+		total = int64(len(up.obj.data) + len(data))
+	}
+	up.obj.data = append(up.obj.data, data...)
+	if total < 0 || int64(len(up.obj.data)) < total {
+		if n := len(up.obj.data); n > 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", n-1))
+		}
+		w.WriteHeader(http.StatusPermanentRedirect)
+		return
+	}
+	b := s.buckets[up.bucket]
+	if b == nil {
+		writeError(w, http.StatusNotFound, "bucket not found")
+		return
+	}
+	delete(s.resumable, id)
+	b.objects[up.obj.name] = up.obj
+	writeJSON(w, http.StatusOK, up.obj.json(b.name))
+}
@@ -0,0 +1,289 @@
+package gcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+	"cloud.google.com/go/storage"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// historyPrefix is the prefix of archives kept in the staging bucket.
+	historyPrefix = "history/"
+	// historyIDFormat is the format of the archive ID; IDs sort by deploy time.
+	// Microseconds keep IDs of deploys within the same second apart.
+	historyIDFormat = "20060102-150405.000000"
+	// historySettingsExt is the extension of objects with function settings,
+	// kept next to the archives.
+	historySettingsExt = ".function"
+
+	metaHash     = "cloudfunc-hash"
+	metaCommit   = "cloudfunc-commit"
+	metaDeployed = "cloudfunc-deployed"
+	metaKeep     = "cloudfunc-keep"
+	// metaStaged marks all archives uploaded by Deploy.
+	metaStaged = "cloudfunc-staged"
+)
+
+// HistoryEntry is a function archive kept in the staging bucket.
+type HistoryEntry struct {
+	// ID of the archive, as accepted by Rollback.
+	ID string
+	// Hash of the build inputs, if known.
+	Hash string
+	// Commit of the function sources, if known.
+	Commit   string
+	Deployed time.Time
+	Size     int64
+	// URL of the archive.
+	URL string
+	// Current is set if the archive is currently deployed.
+	Current bool
+}
+
+func historyObject(name, id string) string {
+	return historyPrefix + name + "/" + id + ".zip"
+}
+
+func historySettingsObject(name, id string) string {
+	return historyPrefix + name + "/" + id + historySettingsExt
+}
+
+// parseHistoryObject returns the function name and the archive ID of the object.
+func parseHistoryObject(obj string) (name, id string, ok bool) {
+	if !strings.HasPrefix(obj, historyPrefix) || !strings.HasSuffix(obj, ".zip") {
+		return "", "", false
+	}
+	obj = strings.TrimSuffix(obj, ".zip")
+	i := strings.LastIndex(obj, "/")
+	if i < len(historyPrefix) {
+		return "", "", false
+	}
+	return obj[len(historyPrefix):i], obj[i+1:], true
+}
+
+// historyMetadata returns the object metadata for an archive of the function.
+func historyMetadata(opt *DeployOptions, now time.Time) map[string]string {
+	return map[string]string{
+		metaHash:     opt.Hash,
+		metaCommit:   opt.Commit,
+		metaDeployed: now.UTC().Format(time.RFC3339),
+		metaKeep:     strconv.Itoa(opt.Keep),
+	}
+}
+
+// writeHistorySettings writes the settings of the function next to its kept
+// archive. They are not stored in the object metadata, which is limited to
+// 8 KiB and is returned to anyone who can list the bucket, while settings
+// include environment variables.
+func (c *Client) writeHistorySettings(ctx context.Context, bucket, name, id string, f *funcs.CloudFunction) error {
+	// keep the settings, but not the source or the state of the function
+	cf := proto.Clone(f).(*funcs.CloudFunction)
+	cf.Name = ""
+	cf.SourceCode = nil
+	cf.Status = 0
+	cf.UpdateTime = nil
+	cf.VersionId = 0
+	cf.BuildId = ""
+	cf.BuildName = ""
+	cf.SourceToken = ""
+	data, err := proto.Marshal(cf)
+	if err != nil {
+		return err
+	}
+	w := c.storage.Bucket(bucket).Object(historySettingsObject(name, id)).NewWriter(ctx)
+	w.ContentType = "application/octet-stream"
+	w.Metadata = map[string]string{metaStaged: "true"}
+	if _, err = w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+// historySettings reads the function settings of a kept archive.
+func (c *Client) historySettings(ctx context.Context, bucket, name string, e *HistoryEntry) (*funcs.CloudFunction, error) {
+	r, err := c.storage.Bucket(bucket).Object(historySettingsObject(name, e.ID)).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, fmt.Errorf("archive %s has no function settings recorded", e.ID)
+	} else if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var cf funcs.CloudFunction
+	if err = proto.Unmarshal(data, &cf); err != nil {
+		return nil, fmt.Errorf("cannot read function settings of archive %s: %v", e.ID, err)
+	}
+	return &cf, nil
+}
+
+// deleteHistory removes a kept archive and its function settings.
+func (c *Client) deleteHistory(ctx context.Context, bucket, name, id string) error {
+	b := c.storage.Bucket(bucket)
+	err := b.Object(historySettingsObject(name, id)).Delete(ctx)
+	if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return err
+	}
+	return b.Object(historyObject(name, id)).Delete(ctx)
+}
+
+// History returns archives of the function kept in the staging bucket,
+// newest first.
+func (c *Client) History(ctx context.Context, name, bucket string) ([]HistoryEntry, error) {
+	cur := ""
+	f, err := c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{
+		Name: c.functionID(name),
+	})
+	if err == nil {
+		cur = f.GetSourceArchiveUrl()
+	} else if status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	var out []HistoryEntry
+	it := c.storage.Bucket(bucket).Objects(ctx, &storage.Query{
+		Prefix: historyPrefix + name + "/",
+	})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		_, id, ok := parseHistoryObject(attrs.Name)
+		if !ok {
+			continue
+		}
+		e := HistoryEntry{
+			ID:     id,
+			Hash:   attrs.Metadata[metaHash],
+			Commit: attrs.Metadata[metaCommit],
+			Size:   attrs.Size,
+			URL:    "gs://" + bucket + "/" + attrs.Name,
+		}
+		e.Current = e.URL == cur
+		if t, err := time.Parse(time.RFC3339, attrs.Metadata[metaDeployed]); err == nil {
+			e.Deployed = t
+		} else {
+			e.Deployed = attrs.Created
+		}
+		out = append(out, e)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID > out[j].ID
+	})
+	return out, nil
+}
+
+// pruneHistory removes all but the last keep archives of the function.
+// The archive that is currently deployed is never removed.
+func (c *Client) pruneHistory(ctx context.Context, name, bucket string, keep int) error {
+	list, err := c.History(ctx, name, bucket)
+	if err != nil {
+		return err
+	}
+	n := 0
+	for _, e := range list {
+		if n < keep || e.Current {
+			n++
+			continue
+		}
+		if err := c.deleteHistory(ctx, bucket, name, e.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Rollback redeploys an archive kept in the staging bucket with the trigger
+// and settings it was deployed with. If id is empty, the archive deployed
+// before the current one is used.
+//
+// Only the Progress, Deployer and NoMetadata fields of the options are used.
+// The DeployerLabel is updated, unless NoMetadata is set.
+func (c *Client) Rollback(ctx context.Context, name, bucket, id string, opt *DeployOptions) error {
+	if opt == nil {
+		opt = &DeployOptions{}
+	}
+	list, err := c.History(ctx, name, bucket)
+	if err != nil {
+		return err
+	}
+	e, err := rollbackTarget(list, id)
+	if err != nil {
+		return err
+	}
+	cf, err := c.historySettings(ctx, bucket, name, e)
+	if err != nil {
+		return err
+	}
+	f := proto.Clone(cf).(*funcs.CloudFunction)
+	f.Name = c.functionID(name)
+	f.SourceCode = &funcs.CloudFunction_SourceArchiveUrl{SourceArchiveUrl: e.URL}
+	if !opt.NoMetadata {
+		d := opt.Deployer
+		if d == "" {
+			d = c.deployer(ctx)
+		}
+		if d = LabelValue(d); d != "" {
+			if f.Labels == nil {
+				f.Labels = make(map[string]string)
+			}
+			f.Labels[DeployerLabel] = d
+		}
+	}
+
+	_, err = c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{Name: f.Name})
+	create := status.Code(err) == codes.NotFound
+	if err != nil && !create {
+		return err
+	}
+	pr := newProgress(opt.Progress)
+	op, err := c.submit(ctx, f, create, pr)
+	if err != nil {
+		return err
+	}
+	// the archive is kept in the history, so don't remove it
+	return c.waitOrCancel(ctx, op, pr, false)
+}
+
+// rollbackTarget selects the archive with a given ID, or the one before
+// the current archive if the ID is empty.
+func rollbackTarget(list []HistoryEntry, id string) (*HistoryEntry, error) {
+	if id != "" {
+		for i := range list {
+			if list[i].ID == id {
+				return &list[i], nil
+			}
+		}
+		return nil, fmt.Errorf("archive %q not found", id)
+	}
+	for i := range list {
+		if list[i].Current {
+			if i+1 < len(list) {
+				return &list[i+1], nil
+			}
+			return nil, fmt.Errorf("no archive before the current one")
+		}
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("no archives kept for the function")
+	}
+	// the current version is not in the history
+	return &list[0], nil
+}
@@ -0,0 +1,51 @@
+package gcp_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/nwca/cloudfunc/gcp"
+)
+
+func TestRollback(t *testing.T) {
+	srv, cli := newTestClient(t)
+	ctx := context.Background()
+	for _, v := range []string{"1", "2", "3"} {
+		opt := &gcp.DeployOptions{
+			NoMetadata: true, StagingBucket: "staging", Keep: 2,
+			Env: map[string]string{"V": v},
+		}
+		if err := cli.Deploy(ctx, "hello", gcp.HTTPTrigger{}, bytes.NewReader([]byte("v"+v)), opt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// deploys within the same second get distinct IDs, the oldest is pruned
+	list, err := cli.History(ctx, "hello", "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || !list[0].Current || list[1].Current || list[0].ID == list[1].ID {
+		t.Fatalf("unexpected history: %+v", list)
+	}
+	if objs := srv.Objects("staging"); len(objs) != 4 {
+		t.Fatalf("expected 2 archives with settings, got %v", objs)
+	}
+	for _, obj := range srv.Objects("staging") {
+		_, meta, _ := srv.Object("staging", obj)
+		if _, ok := meta["cloudfunc-function"]; ok {
+			t.Fatalf("function settings are in metadata of %s", obj)
+		}
+	}
+
+	if err = cli.Rollback(ctx, "hello", "staging", "", &gcp.DeployOptions{NoMetadata: true}); err != nil {
+		t.Fatal(err)
+	}
+	f := srv.Function("projects/" + testProject + "/locations/us-central1/functions/hello")
+	if f.EnvironmentVariables["V"] != "2" {
+		t.Fatalf("settings are not restored: %v", f.EnvironmentVariables)
+	}
+	if data, _ := srv.Source(f); string(data) != "v2" {
+		t.Fatalf("unexpected source: %q", data)
+	}
+}
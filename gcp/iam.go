@@ -0,0 +1,140 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/iam/apiv1/iampb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// InvokerRole allows members to call a function.
+	InvokerRole = "roles/cloudfunctions.invoker"
+	// AllUsers is a member that represents anyone, including unauthenticated users.
+	AllUsers = "allUsers"
+)
+
+// policyRetries is the number of attempts to update a policy that was
+// changed concurrently.
+const policyRetries = 5
+
+// GetIAMPolicy returns the IAM policy of the function. Conditional bindings
+// are included, so the policy can be written back without losing them.
+func (c *Client) GetIAMPolicy(ctx context.Context, name string) (*iampb.Policy, error) {
+	return c.funcs.GetIamPolicy(ctx, &iampb.GetIamPolicyRequest{
+		Resource: c.functionID(name),
+		Options:  &iampb.GetPolicyOptions{RequestedPolicyVersion: 3},
+	})
+}
+
+// SetIAMPolicy replaces the IAM policy of the function. If the etag of the
+// policy is set, the update fails with codes.Aborted when the policy was
+// changed since it was read.
+func (c *Client) SetIAMPolicy(ctx context.Context, name string, p *iampb.Policy) (*iampb.Policy, error) {
+	return c.funcs.SetIamPolicy(ctx, &iampb.SetIamPolicyRequest{
+		Resource: c.functionID(name),
+		Policy:   p,
+	})
+}
+
+// updateIAMPolicy applies the change to the current policy and writes it with
+// the etag it was read with. It retries if the policy was changed concurrently.
+// The change returns false if the policy should not be written.
+func (c *Client) updateIAMPolicy(ctx context.Context, name string, change func(p *iampb.Policy) bool) (*iampb.Policy, error) {
+	for i := 0; ; i++ {
+		p, err := c.GetIAMPolicy(ctx, name)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get policy of %s: %v", name, err)
+		}
+		if !change(p) {
+			return p, nil
+		}
+		np, err := c.SetIAMPolicy(ctx, name, p)
+		if err == nil {
+			return np, nil
+		}
+		if code := status.Code(err); (code != codes.Aborted && code != codes.FailedPrecondition) || i+1 >= policyRetries {
+			return nil, fmt.Errorf("cannot set policy of %s: %v", name, err)
+		}
+	}
+}
+
+// AddInvoker allows the member to call the function.
+func (c *Client) AddInvoker(ctx context.Context, name, member string) (*iampb.Policy, error) {
+	member = NormalizeMember(member)
+	return c.updateIAMPolicy(ctx, name, func(p *iampb.Policy) bool {
+		return AddMember(p, InvokerRole, member)
+	})
+}
+
+// RemoveInvoker removes the member from invokers of the function.
+func (c *Client) RemoveInvoker(ctx context.Context, name, member string) (*iampb.Policy, error) {
+	member = NormalizeMember(member)
+	return c.updateIAMPolicy(ctx, name, func(p *iampb.Policy) bool {
+		return RemoveMember(p, InvokerRole, member)
+	})
+}
+
+// NormalizeMember adds a type prefix to a member that is an email address:
+// serviceAccount: for service accounts and user: otherwise.
+func NormalizeMember(m string) string {
+	if strings.Contains(m, ":") || !strings.Contains(m, "@") {
+		return m
+	}
+	if strings.HasSuffix(m, ".gserviceaccount.com") {
+		return "serviceAccount:" + m
+	}
+	return "user:" + m
+}
+
+// AddMember adds the member to the role binding of the policy, unless it's
+// already there. It reports if the policy was changed.
+func AddMember(p *iampb.Policy, role, member string) bool {
+	var b *iampb.Binding
+	for _, rb := range p.Bindings {
+		if rb.Role == role && rb.Condition == nil {
+			b = rb
+			break
+		}
+	}
+	if b == nil {
+		b = &iampb.Binding{Role: role}
+		p.Bindings = append(p.Bindings, b)
+	}
+	for _, m := range b.Members {
+		if m == member {
+			return false
+		}
+	}
+	b.Members = append(b.Members, member)
+	return true
+}
+
+// RemoveMember removes the member from unconditional bindings of the role.
+// Bindings without members are removed. It reports if the policy was changed.
+func RemoveMember(p *iampb.Policy, role, member string) bool {
+	changed := false
+	bindings := p.Bindings[:0]
+	for _, b := range p.Bindings {
+		if b.Role == role && b.Condition == nil {
+			members := b.Members[:0]
+			for _, m := range b.Members {
+				if m == member {
+					changed = true
+					continue
+				}
+				members = append(members, m)
+			}
+			b.Members = members
+			if len(b.Members) == 0 {
+				continue
+			}
+		}
+		bindings = append(bindings, b)
+	}
+	p.Bindings = bindings
+	return changed
+}
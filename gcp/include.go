@@ -0,0 +1,123 @@
+package gcp
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Include is a set of files added to the function archive.
+type Include struct {
+	// Pattern is a glob pattern of files or directories to add.
+	// Directories are added recursively.
+	Pattern string
+	// Prefix is an optional directory in the archive. If set, each matched
+	// file or directory is placed in it under its base name. Otherwise, the
+	// path of the match is kept.
+	Prefix string
+}
+
+// ParseInclude parses an include in the pattern[:prefix] form.
+func ParseInclude(s string) (Include, error) {
+	inc := Include{Pattern: s}
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		inc.Pattern, inc.Prefix = s[:i], s[i+1:]
+	}
+	if inc.Pattern == "" {
+		return Include{}, fmt.Errorf("empty include pattern: %q", s)
+	}
+	if _, err := filepath.Match(inc.Pattern, ""); err != nil {
+		return Include{}, fmt.Errorf("invalid include pattern %q: %v", inc.Pattern, err)
+	}
+	return inc, nil
+}
+
+// archiveFile is a local file added to the function archive.
+type archiveFile struct {
+	Name string // name in the archive
+	Path string // path on the local file system
+}
+
+// isReserved checks if the name is used by the default runtime shim or
+// by the build.
+func isReserved(name string) bool {
+	switch name {
+	case "index.js", "package.json", "main", functionBin, "env.js", ShimVersionFile, "node_modules":
+		return true
+	}
+	return strings.HasPrefix(name, "node_modules/")
+}
+
+// archiveName converts a local path to a clean slash-separated archive name.
+func archiveName(p string) (string, error) {
+	name := path.Clean(filepath.ToSlash(p))
+	if filepath.IsAbs(p) || name == "." || name == ".." || strings.HasPrefix(name, "../") {
+		return "", fmt.Errorf("%q is outside of the current directory; use a prefix to include it", p)
+	}
+	return name, nil
+}
+
+// includedFiles expands include patterns to a list of files sorted by name.
+// It fails on duplicate names and on names reserved by the runtime shim.
+func includedFiles(incs []Include, sh *Shim) ([]archiveFile, error) {
+	var files []archiveFile
+	seen := make(map[string]string)
+	add := func(name, p string) error {
+		if isReserved(name) || sh.has(name) {
+			return fmt.Errorf("cannot include %q: %q is reserved", p, name)
+		} else if prev, ok := seen[name]; ok {
+			return fmt.Errorf("cannot include %q: %q is already added from %q", p, name, prev)
+		}
+		seen[name] = p
+		files = append(files, archiveFile{Name: name, Path: p})
+		return nil
+	}
+	for _, inc := range incs {
+		matches, err := filepath.Glob(inc.Pattern)
+		if err != nil {
+			return nil, err
+		} else if len(matches) == 0 {
+			return nil, fmt.Errorf("no files match %q", inc.Pattern)
+		}
+		for _, m := range matches {
+			var base string
+			if inc.Prefix != "" {
+				base = path.Join(inc.Prefix, filepath.Base(m))
+			} else {
+				base = m
+			}
+			base, err = archiveName(base)
+			if err != nil {
+				return nil, err
+			}
+			err = filepath.Walk(m, func(p string, fi os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				if fi.Mode()&os.ModeSymlink != 0 {
+					if fi, err = os.Stat(p); err != nil {
+						return err
+					}
+				}
+				if !fi.Mode().IsRegular() {
+					return nil
+				}
+				rel, err := filepath.Rel(m, p)
+				if err != nil {
+					return err
+				}
+				return add(path.Join(base, filepath.ToSlash(rel)), p)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
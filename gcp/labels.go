@@ -0,0 +1,124 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+)
+
+// Labels that record deploy metadata. See DeployOptions.NoMetadata.
+const (
+	VersionLabel  = "cloudfunc-version"
+	CommitLabel   = "cloudfunc-commit"
+	BranchLabel   = "cloudfunc-branch"
+	DeployerLabel = "cloudfunc-deployer"
+)
+
+// metadataPrefix is reserved for labels set by Deploy.
+const metadataPrefix = "cloudfunc-"
+
+// maxLabelLen is the maximal length of label keys and values.
+const maxLabelLen = 63
+
+// IsMetadataLabel reports if the label is set by Deploy, including the HashLabel.
+func IsMetadataLabel(key string) bool {
+	return strings.HasPrefix(key, metadataPrefix)
+}
+
+// LabelValue converts a string to a valid label value: lowercase letters,
+// digits, underscores and dashes, at most 63 characters long.
+func LabelValue(s string) string {
+	b := make([]byte, 0, len(s))
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '_', c == '-':
+			b = append(b, byte(c))
+		default:
+			b = append(b, '-')
+		}
+	}
+	v := strings.Trim(string(b), "-")
+	if len(v) > maxLabelLen {
+		v = strings.TrimRight(v[:maxLabelLen], "-")
+	}
+	return v
+}
+
+// MatchLabels reports if the function has all labels of the filter. Values of
+// the filter are compared both as is and converted with LabelValue.
+func MatchLabels(f *funcs.CloudFunction, filter map[string]string) bool {
+	for k, v := range filter {
+		cur, ok := f.Labels[k]
+		if !ok || (cur != v && cur != LabelValue(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkLabels rejects user labels that use the reserved prefix.
+func checkLabels(labels map[string]string) error {
+	for k := range labels {
+		if IsMetadataLabel(k) {
+			return fmt.Errorf("label %s is reserved, labels with %s prefix are set by cloudfunc", k, metadataPrefix)
+		}
+	}
+	return nil
+}
+
+// setLabels replaces user labels of the function, if they are set in the
+// options, and sets or removes metadata labels.
+func (opt *DeployOptions) setLabels(f *funcs.CloudFunction) error {
+	if opt.Labels != nil {
+		if err := checkLabels(opt.Labels); err != nil {
+			return err
+		}
+		labels := make(map[string]string, len(opt.Labels)+5)
+		for k, v := range f.Labels {
+			if IsMetadataLabel(k) {
+				labels[k] = v
+			}
+		}
+		for k, v := range opt.Labels {
+			labels[k] = v
+		}
+		f.Labels = labels
+	}
+	if f.Labels == nil {
+		f.Labels = make(map[string]string)
+	}
+	set := func(k, v string) {
+		if v = LabelValue(v); v != "" && !opt.NoMetadata {
+			f.Labels[k] = v
+		} else {
+			delete(f.Labels, k)
+		}
+	}
+	set(VersionLabel, Version())
+	set(CommitLabel, opt.Commit)
+	set(BranchLabel, opt.Branch)
+	set(DeployerLabel, opt.Deployer)
+	if opt.Hash != "" {
+		f.Labels[HashLabel] = opt.Hash
+	} else {
+		delete(f.Labels, HashLabel)
+	}
+	return nil
+}
+
+// deployer returns the identity of the client for the DeployerLabel, or an
+// empty string if it's unknown. The identity is cached.
+func (c *Client) deployer(ctx context.Context) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.identity == nil {
+		account, _, err := c.Identity(ctx)
+		if err != nil {
+			account = ""
+		}
+		c.identity = &account
+	}
+	return *c.identity
+}
@@ -0,0 +1,130 @@
+package gcp
+
+import (
+	"strings"
+	"testing"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+)
+
+func TestLabelValue(t *testing.T) {
+	long := strings.Repeat("a", 70)
+	cases := []struct {
+		in, exp string
+	}{
+		{in: "v1", exp: "v1"},
+		{in: "Feature/Login", exp: "feature-login"},
+		{in: "ci@example.com", exp: "ci-example-com"},
+		{in: "release_1.2", exp: "release_1-2"},
+		{in: "--x--", exp: "x"},
+		{in: "héllo", exp: "h-llo"},
+		{in: "@@@", exp: ""},
+		{in: "", exp: ""},
+		{in: long, exp: long[:63]},
+		{in: strings.Repeat("a", 62) + "/b", exp: strings.Repeat("a", 62)},
+	}
+	for _, c := range cases {
+		got := LabelValue(c.in)
+		if got != c.exp {
+			t.Errorf("%q: expected %q, got %q", c.in, c.exp, got)
+		}
+		if len(got) > maxLabelLen {
+			t.Errorf("%q: value is too long: %d", c.in, len(got))
+		}
+	}
+}
+
+func TestMatchLabels(t *testing.T) {
+	f := &funcs.CloudFunction{Labels: map[string]string{
+		"team":        "web",
+		BranchLabel:   "feature-login",
+		DeployerLabel: "ci-example-com",
+	}}
+	cases := []struct {
+		name   string
+		filter map[string]string
+		exp    bool
+	}{
+		{name: "empty", exp: true},
+		{name: "equal", filter: map[string]string{"team": "web"}, exp: true},
+		{name: "all", filter: map[string]string{"team": "web", BranchLabel: "feature-login"}, exp: true},
+		{name: "converted", filter: map[string]string{BranchLabel: "Feature/Login", DeployerLabel: "ci@example.com"}, exp: true},
+		{name: "different value", filter: map[string]string{"team": "api"}},
+		{name: "missing label", filter: map[string]string{"env": "prod"}},
+		{name: "one of many", filter: map[string]string{"team": "web", "env": "prod"}},
+		{name: "empty value", filter: map[string]string{"team": ""}},
+	}
+	for _, c := range cases {
+		if got := MatchLabels(f, c.filter); got != c.exp {
+			t.Errorf("%s: expected %v, got %v", c.name, c.exp, got)
+		}
+	}
+}
+
+func TestSetLabels(t *testing.T) {
+	cases := []struct {
+		name string
+		cur  map[string]string
+		opt  DeployOptions
+		exp  map[string]string
+		fail bool
+	}{
+		{
+			name: "metadata",
+			opt:  DeployOptions{Hash: "abc", Commit: "0123abc", Branch: "Feature/Login", Deployer: "ci@example.com"},
+			exp: map[string]string{
+				HashLabel: "abc", CommitLabel: "0123abc", BranchLabel: "feature-login",
+				DeployerLabel: "ci-example-com", VersionLabel: LabelValue(Version()),
+			},
+		},
+		{
+			name: "no metadata",
+			cur:  map[string]string{"team": "web", CommitLabel: "old", BranchLabel: "old"},
+			opt:  DeployOptions{Hash: "abc", Commit: "0123abc", NoMetadata: true},
+			exp:  map[string]string{"team": "web", HashLabel: "abc"},
+		},
+		{
+			name: "user labels replaced",
+			cur:  map[string]string{"team": "web", "env": "prod", HashLabel: "old"},
+			opt:  DeployOptions{Labels: map[string]string{"team": "api"}, NoMetadata: true},
+			exp:  map[string]string{"team": "api"},
+		},
+		{
+			name: "user labels kept",
+			cur:  map[string]string{"team": "web"},
+			opt:  DeployOptions{NoMetadata: true},
+			exp:  map[string]string{"team": "web"},
+		},
+		{
+			name: "reserved label",
+			opt:  DeployOptions{Labels: map[string]string{CommitLabel: "x"}},
+			fail: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := &funcs.CloudFunction{Labels: c.cur}
+			err := c.opt.setLabels(f)
+			if c.fail {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			exp := c.exp
+			if v := exp[VersionLabel]; v == "" {
+				delete(exp, VersionLabel)
+			}
+			if len(f.Labels) != len(exp) {
+				t.Fatalf("expected %v, got %v", exp, f.Labels)
+			}
+			for k, v := range exp {
+				if f.Labels[k] != v {
+					t.Errorf("%s: expected %q, got %q", k, v, f.Labels[k])
+				}
+			}
+		})
+	}
+}
@@ -2,25 +2,97 @@ package gcp
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strconv"
 
-	"google.golang.org/api/transport"
-	funcs "google.golang.org/genproto/googleapis/cloud/functions/v1beta2"
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+	"cloud.google.com/go/longrunning"
 )
 
 type CloudFunction = funcs.CloudFunction
 
 func (c *Client) ListFuncs() ([]*CloudFunction, error) {
 	ctx := context.Background()
-	conn, err := transport.DialGRPC(ctx, defaultFuncsClientOptions()...)
-	if err != nil {
-		return nil, err
+	var out []*CloudFunction
+	req := &funcs.ListFunctionsRequest{
+		Parent: "projects/" + c.project + "/locations/-",
+	}
+	for {
+		resp, err := c.funcs.ListFunctions(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, resp.Functions...)
+		if resp.NextPageToken == "" {
+			return out, nil
+		}
+		req.PageToken = resp.NextPageToken
 	}
-	cli := funcs.NewCloudFunctionsServiceClient(conn)
-	resp, err := cli.ListFunctions(ctx, &funcs.ListFunctionsRequest{
-		Location: "projects/" + c.project + "/locations/-",
+}
+
+// GetFunc returns the deployed function.
+func (c *Client) GetFunc(ctx context.Context, name string) (*CloudFunction, error) {
+	return c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{
+		Name: c.functionID(name),
+	})
+}
+
+// DeleteFunc deletes the function and waits for the operation to complete.
+func (c *Client) DeleteFunc(ctx context.Context, name string) error {
+	oppb, err := c.funcs.DeleteFunction(ctx, &funcs.DeleteFunctionRequest{
+		Name: c.functionID(name),
 	})
 	if err != nil {
-		return nil, err
+		return err
+	}
+	return longrunning.InternalNewOperation(c.long, oppb).Wait(ctx, nil)
+}
+
+// Describe writes settings and labels of the function.
+func Describe(w io.Writer, f *CloudFunction) error {
+	var err error
+	field := func(name, val string) {
+		if val != "" && err == nil {
+			_, err = fmt.Fprintf(w, "%-16s %s\n", name+":", val)
+		}
+	}
+	field("name", path.Base(f.Name))
+	field("status", f.Status.String())
+	field("trigger", triggerName(f))
+	field("event resource", f.GetEventTrigger().GetResource())
+	field("url", f.GetHttpsTrigger().GetUrl())
+	field("runtime", f.Runtime)
+	field("entry point", f.EntryPoint)
+	field("memory", formatMemory(f.AvailableMemoryMb))
+	field("timeout", formatTimeout(f))
+	field("max instances", formatInt(f.MaxInstances))
+	field("vpc connector", f.VpcConnector)
+	field("ingress", formatIngress(f.IngressSettings))
+	field("service account", f.ServiceAccountEmail)
+	if f.VersionId != 0 {
+		field("version", strconv.FormatInt(f.VersionId, 10))
+	}
+	if t := f.UpdateTime; t != nil {
+		field("updated", t.AsTime().UTC().Format("2006-01-02 15:04:05 MST"))
+	}
+	if len(f.Labels) == 0 || err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(f.Labels))
+	for k := range f.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	if _, err = fmt.Fprintln(w, "labels:"); err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if _, err = fmt.Fprintf(w, "  %s=%s\n", k, f.Labels[k]); err != nil {
+			return err
+		}
 	}
-	return resp.Functions, nil
+	return nil
 }
@@ -0,0 +1,249 @@
+package gcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+// shimModule is the module path of the generated function binary.
+const shimModule = "cloudfunc.local/shim"
+
+// cloudfuncModule is the module path of this repository. Generated sources
+// import it, so it's pinned to the version of the CLI that generated them.
+const cloudfuncModule = "github.com/nwca/cloudfunc"
+
+// zeroVersion is the version used to require replaced modules.
+const zeroVersion = "v0.0.0-00010101000000-000000000000"
+
+// Module is a Go module that provides the target package.
+type Module struct {
+	Path      string
+	Dir       string
+	GoVersion string
+}
+
+type goPackage struct {
+	ImportPath string
+	Dir        string
+	Module     *Module
+}
+
+// listPackage resolves an import path or a directory to a Go package. If the
+// package is not in a module, it's resolved in GOPATH mode.
+func listPackage(pkg string) (*goPackage, error) {
+	stderr := bytes.NewBuffer(nil)
+	p, err := goList(pkg, true, stderr)
+	if err != nil {
+		var gerr error
+		if p, gerr = goList(pkg, false, ioutil.Discard); gerr != nil {
+			os.Stderr.Write(stderr.Bytes())
+			return nil, fmt.Errorf("cannot resolve package %q: %v", pkg, err)
+		}
+	}
+	if p.Module == nil && strings.HasPrefix(p.ImportPath, "_") {
+		return nil, fmt.Errorf("package %q is neither in GOPATH nor in a module", pkg)
+	}
+	return p, nil
+}
+
+// goList runs go list for the package either in module or in GOPATH mode.
+func goList(pkg string, modules bool, stderr io.Writer) (*goPackage, error) {
+	cmd := goCommand("", "list", "-json", pkg)
+	cmd.Stderr = stderr
+	if err := setModeEnv(cmd, modules); err != nil {
+		return nil, err
+	}
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var p goPackage
+	if err = json.Unmarshal(data, &p); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+type goModFile struct {
+	Require []struct {
+		Path    string
+		Version string
+	}
+	Replace []struct {
+		Old struct {
+			Path    string
+			Version string
+		}
+		New struct {
+			Path    string
+			Version string
+		}
+	}
+}
+
+func readGoMod(dir string) (*goModFile, error) {
+	cmd := goCommand(dir, "mod", "edit", "-json")
+	if err := setModeEnv(cmd, true); err != nil {
+		return nil, err
+	}
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read go.mod: %v", err)
+	}
+	var f goModFile
+	if err = json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// selfModule returns the version of the cloudfunc module this binary was
+// built from and, for development builds and replaced modules, the target of
+// a replace directive: either an absolute directory or a path and a version.
+// Both are empty if the module is unknown, e.g. in GOPATH builds.
+func selfModule() (version, replace string) {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, m := range append([]*debug.Module{&bi.Main}, bi.Deps...) {
+			if m.Path != cloudfuncModule {
+				continue
+			}
+			if r := m.Replace; r != nil && r.Version != "" {
+				return zeroVersion, r.Path + " " + r.Version
+			} else if r != nil && filepath.IsAbs(r.Path) {
+				return zeroVersion, r.Path
+			}
+			if m.Version != "" && m.Version != "(devel)" {
+				return m.Version, ""
+			}
+		}
+	}
+	// built from source, use it if it's a module
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return "", ""
+	}
+	dir := filepath.Dir(filepath.Dir(file))
+	if _, err := os.Stat(filepath.Join(dir, "go.mod")); err != nil {
+		return "", ""
+	}
+	return zeroVersion, dir
+}
+
+// pinCloudfunc returns the version of the cloudfunc module required by the
+// generated go.mod and the target of its replace directive, if any, checking
+// that they don't conflict with the user module. Both are empty if the version
+// is unknown or the user module is cloudfunc itself; the requirement of the
+// user module is used then.
+func pinCloudfunc(m *Module, mf *goModFile) (version, replace string, _ error) {
+	if m.Path == cloudfuncModule {
+		return "", "", nil
+	}
+	version, replace = selfModule()
+	if version == "" {
+		log.Printf("warning: unknown version of %s, using the one required by %s", cloudfuncModule, m.Path)
+		return "", "", nil
+	}
+	for _, r := range mf.Require {
+		if r.Path == cloudfuncModule && replace == "" && r.Version != version {
+			return "", "", fmt.Errorf("%s requires %s %s, but cloudfunc is %s; require the same version or use a matching cloudfunc",
+				m.Path, cloudfuncModule, r.Version, version)
+		}
+	}
+	for _, r := range mf.Replace {
+		if r.Old.Path != cloudfuncModule {
+			continue
+		}
+		target := r.New.Path + " " + r.New.Version
+		if r.New.Version == "" {
+			target = r.New.Path
+			if !filepath.IsAbs(target) {
+				target = filepath.Join(m.Dir, target)
+			}
+			target = filepath.Clean(target)
+		}
+		if target != replace {
+			self := version
+			if replace != "" {
+				self = replace
+			}
+			return "", "", fmt.Errorf("%s replaces %s with %s, but cloudfunc is built from %s; remove the replace directive or use a matching cloudfunc",
+				m.Path, cloudfuncModule, target, self)
+		}
+	}
+	return version, replace, nil
+}
+
+// writeGoMod writes a go.mod file for the generated binary. It requires the
+// user module from its local directory and copies its replace directives,
+// since they only apply to the main module. The cloudfunc module is pinned to
+// the version of the CLI, see pinCloudfunc.
+func writeGoMod(dir string, m *Module) error {
+	mf, err := readGoMod(m.Dir)
+	if err != nil {
+		return err
+	}
+	cfVersion, cfReplace, err := pinCloudfunc(m, mf)
+	if err != nil {
+		return err
+	}
+	err = writeSource(filepath.Join(dir, "go.mod"), func(w io.Writer) error {
+		buf := bytes.NewBuffer(nil)
+		fmt.Fprintf(buf, "module %s\n\n", shimModule)
+		if m.GoVersion != "" {
+			fmt.Fprintf(buf, "go %s\n\n", m.GoVersion)
+		}
+		fmt.Fprintf(buf, "require %s %s\n", m.Path, zeroVersion)
+		if cfVersion != "" {
+			fmt.Fprintf(buf, "require %s %s\n", cloudfuncModule, cfVersion)
+		}
+		fmt.Fprintf(buf, "\nreplace %s => %s\n", m.Path, m.Dir)
+		if cfReplace != "" {
+			fmt.Fprintf(buf, "replace %s => %s\n", cloudfuncModule, cfReplace)
+		}
+		for _, r := range mf.Replace {
+			if r.Old.Path == m.Path || (cfVersion != "" && r.Old.Path == cloudfuncModule) {
+				continue
+			}
+			old := r.Old.Path
+			if r.Old.Version != "" {
+				old += " " + r.Old.Version
+			}
+			repl := r.New.Path
+			if r.New.Version != "" {
+				repl += " " + r.New.Version
+			} else if !filepath.IsAbs(repl) {
+				repl = filepath.Join(m.Dir, repl)
+			}
+			fmt.Fprintf(buf, "replace %s => %s\n", old, repl)
+		}
+		_, err := w.Write(buf.Bytes())
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	// reuse checksums of the user module, so it resolves to the same versions
+	sum, err := ioutil.ReadFile(filepath.Join(m.Dir, "go.sum"))
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, "go.sum"), sum, 0644)
+	} else if os.IsNotExist(err) {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	cmd := goCommand(dir, "mod", "tidy")
+	if err := setModeEnv(cmd, true); err != nil {
+		return err
+	}
+	return cmd.Run()
+}
@@ -0,0 +1,458 @@
+package gcp
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// NativeEntryPoint is the exported function of the package generated
+	// for the native Go runtimes.
+	NativeEntryPoint = "Function"
+	// nodeEntryPoint is the function exported by index.js of the node shim.
+	nodeEntryPoint = "helloWorld"
+	// nativeModule is the module path of the package generated for the
+	// native Go runtimes.
+	nativeModule = "cloudfunc.local/function"
+	// nativeSrcDir is the directory of the user module in the archive.
+	nativeSrcDir = "src"
+	// nativeCloudfuncDir is the directory of the cloudfunc module in the
+	// archive, if the CLI was built from source.
+	nativeCloudfuncDir = "cloudfunc"
+	// nativeCodeLocation is the directory of the function source relative to
+	// the working directory of the native Go runtimes.
+	nativeCodeLocation = "./serverless_function_source_code"
+)
+
+// IsNativeRuntime checks if the runtime is one of the native Go runtimes,
+// for example go113. Functions for other runtimes are started by the node shim.
+func IsNativeRuntime(rt string) bool {
+	return strings.HasPrefix(rt, "go")
+}
+
+// buildNative writes an archive with the sources of the user module and
+// a generated package that adapts the target to the native Go runtime.
+func buildNative(tr Trigger, opt *BuildOptions, out io.Writer) error {
+	m := tr.target().Module
+	if m == nil {
+		return fmt.Errorf("native runtime %s requires the function to be in a Go module", opt.Runtime)
+	}
+	if err := checkNativeOptions(opt); err != nil {
+		return err
+	}
+	if _, ok := tr.(HTTPTrigger); !ok && tr.target().Func == "" {
+		return fmt.Errorf("event triggers require a function in the target, e.g. %s.Handle", tr.target().Package)
+	}
+	files, err := includedFiles(opt.Include, nil)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if isNativeReserved(f.Name) {
+			return fmt.Errorf("cannot include %q: %q is reserved", f.Path, f.Name)
+		}
+	}
+	dir, err := ioutil.TempDir("", "cloudfunc-")
+	if err != nil {
+		return err
+	}
+	log.Println("build dir:", dir)
+	defer os.RemoveAll(dir)
+
+	if err := copyModule(filepath.Join(dir, nativeSrcDir), m.Dir); err != nil {
+		return fmt.Errorf("cannot copy module: %v", err)
+	}
+	if err := writeNativeGoMod(dir, m); err != nil {
+		return fmt.Errorf("cannot write go.mod: %v", err)
+	}
+	err = writeSource(filepath.Join(dir, "function.go"), tr.writeNative)
+	if err != nil {
+		return fmt.Errorf("cannot write entry point: %v", err)
+	}
+	// the platform builds the function, but check that it compiles first
+	cmd := goCommand(dir, "build", "-o", os.DevNull, ".")
+	if err := setBuildEnv(cmd, true); err != nil {
+		return err
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("cannot build function: %v", err)
+	}
+	src, err := listFiles(dir)
+	if err != nil {
+		return err
+	}
+	size, err := writeArchive(&Shim{}, out, opt.Compression, append(src, files...)...)
+	if err != nil {
+		return err
+	}
+	log.Println("archive size:", size)
+	return size.Check(opt.SizeBudget)
+}
+
+// DeployEnv returns environment variables that Deploy sets on a function
+// built with given options.
+func DeployEnv(opt *BuildOptions) map[string]string {
+	env := make(map[string]string, len(opt.Env)+1)
+	for k, v := range opt.Env {
+		env[k] = v
+	}
+	if IsNativeRuntime(opt.Runtime) && len(opt.Include) != 0 {
+		env["CODE_LOCATION"] = nativeCodeLocation
+	}
+	return env
+}
+
+// checkNativeOptions rejects options that are implemented by the node shim
+// or need control over go build.
+func checkNativeOptions(opt *BuildOptions) error {
+	if err := checkNativeEnv(opt.Env); err != nil {
+		return err
+	}
+	switch {
+	case opt.Smoke:
+		return fmt.Errorf("smoke test is not supported by native runtimes")
+	case opt.Shim != nil:
+		return fmt.Errorf("custom shim is not supported by native runtimes")
+	case opt.SecretResolver != "":
+		return fmt.Errorf("secret resolver package is not supported by native runtimes, call cloudfunc.SetSecretResolver from the function package")
+	case opt.Strip || opt.LDFlags != "" || len(opt.Flags) != 0 || len(opt.Tags) != 0:
+		return fmt.Errorf("build flags are not supported by native runtimes, the function is built by the platform")
+	}
+	return nil
+}
+
+// checkNativeEnv rejects CORS and authentication settings, since the native
+// entry point doesn't apply them.
+func checkNativeEnv(env map[string]string) error {
+	for k := range env {
+		if strings.HasPrefix(k, "CLOUDFUNC_CORS_") || strings.HasPrefix(k, "CLOUDFUNC_AUTH_") {
+			return fmt.Errorf("CORS and authentication options are not supported by native runtimes")
+		}
+	}
+	return nil
+}
+
+func isNativeReserved(name string) bool {
+	switch name {
+	case "go.mod", "go.sum", "function.go", nativeSrcDir, nativeCloudfuncDir:
+		return true
+	}
+	return strings.HasPrefix(name, nativeSrcDir+"/") || strings.HasPrefix(name, nativeCloudfuncDir+"/")
+}
+
+// copyModule copies the sources of the module, skipping hidden files and
+// directories.
+func copyModule(dst, src string) error {
+	return filepath.Walk(src, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if rel == "." {
+				return os.MkdirAll(dst, 0755)
+			}
+			if strings.HasPrefix(fi.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return os.MkdirAll(filepath.Join(dst, rel), 0755)
+		}
+		if !fi.Mode().IsRegular() || strings.HasPrefix(fi.Name(), ".") {
+			return nil
+		}
+		data, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dst, rel), data, fi.Mode().Perm())
+	})
+}
+
+// listFiles lists all files in the directory as archive files.
+func listFiles(dir string) ([]archiveFile, error) {
+	var files []archiveFile
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil || !fi.Mode().IsRegular() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, archiveFile{Name: filepath.ToSlash(rel), Path: p})
+		return nil
+	})
+	return files, err
+}
+
+// writeNativeGoMod writes a go.mod file for the generated package. Unlike
+// writeGoMod, it requires the user module from the copy in the archive,
+// so only replace directives that point into the module can be kept.
+// The cloudfunc module is pinned like in writeGoMod, and copied to the archive
+// if the CLI was built from source.
+func writeNativeGoMod(dir string, m *Module) error {
+	mf, err := readGoMod(m.Dir)
+	if err != nil {
+		return err
+	}
+	cfVersion, cfReplace, err := pinCloudfunc(m, mf)
+	if err != nil {
+		return err
+	}
+	var repl []string
+	if filepath.IsAbs(cfReplace) {
+		if err := copyModule(filepath.Join(dir, nativeCloudfuncDir), cfReplace); err != nil {
+			return fmt.Errorf("cannot copy %s: %v", cloudfuncModule, err)
+		}
+		cfReplace = "./" + nativeCloudfuncDir
+	}
+	if cfReplace != "" {
+		repl = append(repl, fmt.Sprintf("replace %s => %s\n", cloudfuncModule, cfReplace))
+	}
+	for _, r := range mf.Replace {
+		if r.Old.Path == m.Path || (cfVersion != "" && r.Old.Path == cloudfuncModule) {
+			continue
+		}
+		old := r.Old.Path
+		if r.Old.Version != "" {
+			old += " " + r.Old.Version
+		}
+		if r.New.Version != "" {
+			repl = append(repl, fmt.Sprintf("replace %s => %s %s\n", old, r.New.Path, r.New.Version))
+			continue
+		}
+		p := r.New.Path
+		if filepath.IsAbs(p) {
+			if p, err = filepath.Rel(m.Dir, p); err != nil {
+				return err
+			}
+		}
+		p = path.Clean(filepath.ToSlash(p))
+		if p == ".." || strings.HasPrefix(p, "../") {
+			return fmt.Errorf("replace directive for %s points outside of the module: %s", r.Old.Path, r.New.Path)
+		}
+		repl = append(repl, fmt.Sprintf("replace %s => ./%s\n", old, path.Join(nativeSrcDir, p)))
+	}
+	err = writeSource(filepath.Join(dir, "go.mod"), func(w io.Writer) error {
+		fmt.Fprintf(w, "module %s\n\n", nativeModule)
+		if m.GoVersion != "" {
+			fmt.Fprintf(w, "go %s\n\n", m.GoVersion)
+		}
+		fmt.Fprintf(w, "require %s %s\n", m.Path, zeroVersion)
+		if cfVersion != "" {
+			fmt.Fprintf(w, "require %s %s\n", cloudfuncModule, cfVersion)
+		}
+		fmt.Fprintf(w, "\nreplace %s => ./%s\n", m.Path, nativeSrcDir)
+		for _, r := range repl {
+			if _, err := io.WriteString(w, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sum, err := ioutil.ReadFile(filepath.Join(m.Dir, "go.sum"))
+	if err == nil {
+		err = ioutil.WriteFile(filepath.Join(dir, "go.sum"), sum, 0644)
+	} else if os.IsNotExist(err) {
+		err = nil
+	}
+	if err != nil {
+		return err
+	}
+	cmd := goCommand(dir, "mod", "tidy")
+	if err := setModeEnv(cmd, true); err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
+// nativeHeader starts the source of the generated package.
+const nativeHeader = `// Code generated by cloudfunc. DO NOT EDIT.
+
+// Package function adapts %s to the native Go runtime.
+package function
+
+`
+
+// nativeInit runs user init functions when the runtime loads the package.
+const nativeInit = `
+func init() {
+	timeout, err := time.ParseDuration(os.Getenv("CLOUDFUNC_INIT_TIMEOUT"))
+	if err != nil || timeout <= 0 {
+		timeout = time.Minute
+	}
+	retries, _ := strconv.Atoi(os.Getenv("CLOUDFUNC_INIT_RETRIES"))
+	for i := 0; ; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err = cloudfunc.Init(ctx)
+		cancel()
+		if err == nil {
+			return
+		} else if i >= retries {
+			panic(err)
+		}
+		log.Printf("init failed (attempt %d of %d): %v", i+1, retries+1, err)
+		time.Sleep(time.Second)
+	}
+}
+`
+
+func (t HTTPTrigger) writeNative(w io.Writer) error {
+	imp, h := "p", "http.HandlerFunc(p."+t.Func+")"
+	if t.Func == "" {
+		imp, h = "_", "http.DefaultServeMux"
+	}
+	_, err := fmt.Fprintf(w, nativeHeader+`import (
+	"context"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+	%s %q
+)
+
+var handler = cloudfunc.Wrap(%s)
+
+// %s is the entry point of the function.
+func %[5]s(w http.ResponseWriter, r *http.Request) {
+	handler.ServeHTTP(w, r)
+}
+`, t.Package, imp, t.Package, h, NativeEntryPoint)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, nativeInit)
+	return err
+}
+
+func (t TopicTrigger) writeNative(w io.Writer) error {
+	_, err := fmt.Fprintf(w, nativeHeader+`import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/nwca/cloudfunc"
+	p %q
+)
+
+// Message is the payload of a Pub/Sub event.
+type Message struct {
+	Data       []byte            `+"`json:\"data\"`"+`
+	Attributes map[string]string `+"`json:\"attributes\"`"+`
+}
+
+// %s is the entry point of the function.
+func %[3]s(ctx context.Context, m Message) error {
+	msg := &pubsub.Message{Data: m.Data, Attributes: m.Attributes}
+	if msg.Attributes == nil {
+		msg.Attributes = make(map[string]string)
+	}
+	return p.%s(ctx, msg)
+}
+`, t.Package, t.Package, NativeEntryPoint, t.Func)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, nativeInit)
+	return err
+}
+
+func (t StorageTrigger) writeNative(w io.Writer) error {
+	_, err := fmt.Fprintf(w, nativeHeader+`import (
+	"context"
+	"encoding/base64"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/nwca/cloudfunc"
+	raw "google.golang.org/api/storage/v1"
+	p %q
+)
+
+// %s is the entry point of the function.
+func %[3]s(ctx context.Context, o raw.Object) error {
+	return p.%s(ctx, newObject(&o))
+}
+
+func newObject(o *raw.Object) *storage.ObjectAttrs {
+	acl := make([]storage.ACLRule, len(o.Acl))
+	for i, rule := range o.Acl {
+		acl[i] = storage.ACLRule{
+			Entity: storage.ACLEntity(rule.Entity),
+			Role:   storage.ACLRole(rule.Role),
+		}
+	}
+	owner := ""
+	if o.Owner != nil {
+		owner = o.Owner.Entity
+	}
+	md5, _ := base64.StdEncoding.DecodeString(o.Md5Hash)
+	var crc32c uint32
+	if d, err := base64.StdEncoding.DecodeString(o.Crc32c); err == nil && len(d) == 4 {
+		crc32c = uint32(d[0])<<24 + uint32(d[1])<<16 + uint32(d[2])<<8 + uint32(d[3])
+	}
+	var sha256 string
+	if o.CustomerEncryption != nil {
+		sha256 = o.CustomerEncryption.KeySha256
+	}
+	return &storage.ObjectAttrs{
+		Bucket:             o.Bucket,
+		Name:               o.Name,
+		ContentType:        o.ContentType,
+		ContentLanguage:    o.ContentLanguage,
+		CacheControl:       o.CacheControl,
+		ACL:                acl,
+		Owner:              owner,
+		ContentEncoding:    o.ContentEncoding,
+		ContentDisposition: o.ContentDisposition,
+		Size:               int64(o.Size),
+		MD5:                md5,
+		CRC32C:             crc32c,
+		MediaLink:          o.MediaLink,
+		Metadata:           o.Metadata,
+		Generation:         o.Generation,
+		Metageneration:     o.Metageneration,
+		StorageClass:       o.StorageClass,
+		CustomerKeySHA256:  sha256,
+		KMSKeyName:         o.KmsKeyName,
+		Created:            convertTime(o.TimeCreated),
+		Deleted:            convertTime(o.TimeDeleted),
+		Updated:            convertTime(o.Updated),
+	}
+}
+
+func convertTime(t string) time.Time {
+	var r time.Time
+	if t != "" {
+		r, _ = time.Parse(time.RFC3339, t)
+	}
+	return r
+}
+`, t.Package, t.Package, NativeEntryPoint, t.Func)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, nativeInit)
+	return err
+}
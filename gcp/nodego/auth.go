@@ -0,0 +1,395 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+)
+
+const (
+	googleJWKSURL = "https://www.googleapis.com/oauth2/v3/certs"
+	iapJWKSURL    = "https://www.gstatic.com/iap/verify/public_key-jwk"
+	iapIssuer     = "https://cloud.google.com/iap"
+	iapHeader     = "X-Goog-Iap-Jwt-Assertion"
+
+	jwksDefaultTTL     = time.Hour
+	jwksRefreshBackoff = time.Minute
+	tokenClockSkew     = 30 * time.Second
+)
+
+var googleIssuers = []string{"https://accounts.google.com", "accounts.google.com"}
+
+type authOptions struct {
+	Audience   string
+	Principals []string
+	IAP        bool
+	JWKSURL    string
+}
+
+var auth = authFromEnv()
+
+func authFromEnv() authOptions {
+	opt := authOptions{
+		Audience:   os.Getenv("CLOUDFUNC_AUTH_AUDIENCE"),
+		Principals: splitList(os.Getenv("CLOUDFUNC_AUTH_PRINCIPALS")),
+		IAP:        os.Getenv("CLOUDFUNC_AUTH_IAP") == "true",
+		JWKSURL:    os.Getenv("CLOUDFUNC_AUTH_JWKS_URL"),
+	}
+	if opt.JWKSURL == "" {
+		if opt.IAP {
+			opt.JWKSURL = iapJWKSURL
+		} else {
+			opt.JWKSURL = googleJWKSURL
+		}
+	}
+	return opt
+}
+
+func (a authOptions) enabled() bool {
+	return a.Audience != ""
+}
+
+func (a authOptions) allowPrincipal(c *cloudfunc.Claims) bool {
+	if len(a.Principals) == 0 {
+		return true
+	}
+	for _, p := range a.Principals {
+		if i := strings.Index(p, ":"); i >= 0 {
+			// serviceAccount:x, user:x
+			p = p[i+1:]
+		}
+		if (c.Email != "" && p == c.Email) || p == c.Subject {
+			return true
+		}
+	}
+	return false
+}
+
+func (a authOptions) allowIssuer(iss string) bool {
+	if a.IAP {
+		return iss == iapIssuer
+	}
+	for _, s := range googleIssuers {
+		if iss == s {
+			return true
+		}
+	}
+	return false
+}
+
+// authHandler verifies Google-signed ID tokens or IAP JWT assertions and puts
+// the verified claims into the request context.
+type authHandler struct {
+	opt  authOptions
+	keys *keyCache
+	h    http.Handler
+}
+
+func newAuthHandler(opt authOptions, h http.Handler) authHandler {
+	return authHandler{opt: opt, keys: &keyCache{url: opt.JWKSURL}, h: h}
+}
+
+func (h authHandler) token(r *http.Request) string {
+	if h.opt.IAP {
+		return r.Header.Get(iapHeader)
+	}
+	const pref = "Bearer "
+	v := r.Header.Get("Authorization")
+	if len(v) <= len(pref) || !strings.EqualFold(v[:len(pref)], pref) {
+		return ""
+	}
+	return v[len(pref):]
+}
+
+func (h authHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tok := h.token(r)
+	if tok == "" {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	c, err := h.verify(r.Context(), tok)
+	if err != nil {
+		log.Println("auth:", err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if !h.opt.allowPrincipal(c) {
+		log.Printf("auth: principal %q is not allowed", c.Email)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	h.h.ServeHTTP(w, r.WithContext(cloudfunc.WithClaims(r.Context(), c)))
+}
+
+func decodeSegment(s string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (h authHandler) verify(ctx context.Context, tok string) (*cloudfunc.Claims, error) {
+	parts := strings.Split(tok, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed token")
+	}
+	var hdr struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &hdr); err != nil {
+		return nil, fmt.Errorf("cannot decode token header: %v", err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode token signature: %v", err)
+	}
+	key, err := h.keys.get(ctx, hdr.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if err = verifySignature(hdr.Alg, key, parts[0]+"."+parts[1], sig); err != nil {
+		return nil, err
+	}
+
+	c := &cloudfunc.Claims{}
+	if err = decodeSegment(parts[1], c); err != nil {
+		return nil, fmt.Errorf("cannot decode token claims: %v", err)
+	}
+	if err = decodeSegment(parts[1], &c.Raw); err != nil {
+		return nil, fmt.Errorf("cannot decode token claims: %v", err)
+	}
+	switch aud := c.Raw["aud"].(type) {
+	case string:
+		c.Audience = []string{aud}
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				c.Audience = append(c.Audience, s)
+			}
+		}
+	}
+
+	now := time.Now()
+	if exp := time.Unix(c.ExpiresAt, 0); now.After(exp.Add(tokenClockSkew)) {
+		return nil, fmt.Errorf("token expired at %v", exp)
+	}
+	if iat := time.Unix(c.IssuedAt, 0); now.Before(iat.Add(-tokenClockSkew)) {
+		return nil, fmt.Errorf("token issued in the future: %v", iat)
+	}
+	if !h.opt.allowIssuer(c.Issuer) {
+		return nil, fmt.Errorf("unexpected token issuer: %q", c.Issuer)
+	}
+	for _, a := range c.Audience {
+		if a == h.opt.Audience {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected token audience: %q", c.Audience)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, sig []byte) error {
+	hash := sha256.Sum256([]byte(signed))
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type doesn't match algorithm %s", alg)
+		}
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, hash[:], sig)
+	case "ES256":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("key type doesn't match algorithm %s", alg)
+		}
+		if len(sig) != 64 {
+			return errors.New("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if !ecdsa.Verify(pub, hash[:], r, s) {
+			return errors.New("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported signing algorithm: %q", alg)
+}
+
+// keyCache fetches and caches public keys from a JWKS endpoint. Keys are
+// fetched without holding the lock, so a slow endpoint doesn't block requests
+// with cached keys; concurrent requests wait for the same fetch.
+type keyCache struct {
+	url string
+
+	mu       sync.Mutex
+	keys     map[string]crypto.PublicKey
+	fetched  time.Time
+	expires  time.Time
+	fetching *keyFetch
+}
+
+// keyFetch is a fetch of the key set in progress.
+type keyFetch struct {
+	done chan struct{}
+	err  error
+}
+
+func (c *keyCache) get(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	c.mu.Lock()
+	now := time.Now()
+	key, ok := c.keys[kid]
+	if ok && now.Before(c.expires) {
+		c.mu.Unlock()
+		return key, nil
+	}
+	f := c.fetching
+	// keys might have been rotated, but don't hammer the endpoint with unknown key ids
+	if !ok && f == nil && now.Sub(c.fetched) <= jwksRefreshBackoff {
+		c.mu.Unlock()
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+	if f == nil {
+		f = &keyFetch{done: make(chan struct{})}
+		c.fetching = f
+		c.mu.Unlock()
+		keys, expires, err := fetchKeys(ctx, c.url)
+		c.mu.Lock()
+		if err == nil {
+			c.keys, c.fetched, c.expires = keys, time.Now(), expires
+		}
+		f.err = err
+		c.fetching = nil
+		close(f.done)
+	} else {
+		c.mu.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		c.mu.Lock()
+	}
+	defer c.mu.Unlock()
+	if f.err != nil {
+		if ok {
+			log.Println("auth: cannot refresh keys:", f.err)
+			return key, nil
+		}
+		return nil, f.err
+	}
+	if key, ok = c.keys[kid]; !ok {
+		return nil, fmt.Errorf("unknown signing key: %q", kid)
+	}
+	return key, nil
+}
+
+// fetchKeys fetches the key set and returns it with its expiration time.
+func fetchKeys(ctx context.Context, url string) (map[string]crypto.PublicKey, time.Time, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("cannot fetch keys: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Time{}, fmt.Errorf("cannot fetch keys: status %d", resp.StatusCode)
+	}
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err = json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, time.Time{}, fmt.Errorf("cannot decode keys: %v", err)
+	}
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		pub, err := k.publicKey()
+		if err != nil {
+			log.Printf("auth: skipping key %q: %v", k.Kid, err)
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	return keys, time.Now().Add(cacheMaxAge(resp.Header.Get("Cache-Control"))), nil
+}
+
+func cacheMaxAge(cc string) time.Duration {
+	for _, v := range strings.Split(cc, ",") {
+		v = strings.TrimSpace(v)
+		if !strings.HasPrefix(v, "max-age=") {
+			continue
+		}
+		if sec, err := strconv.Atoi(strings.TrimPrefix(v, "max-age=")); err == nil && sec > 0 {
+			return time.Duration(sec) * time.Second
+		}
+	}
+	return jwksDefaultTTL
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	num := func(s string) (*big.Int, error) {
+		data, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		return new(big.Int).SetBytes(data), nil
+	}
+	switch k.Kty {
+	case "RSA":
+		n, err := num(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := num(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve: %q", k.Crv)
+		}
+		x, err := num(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := num(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type: %q", k.Kty)
+}
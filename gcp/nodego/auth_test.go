@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+)
+
+const testAudience = "https://func.example.com"
+
+type testKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	rk, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ek, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testKeys{rsa: rk, ec: ek}
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// jwks returns the public keys as a JWKS document.
+func (k *testKeys) jwks() []byte {
+	set := map[string]interface{}{"keys": []jsonWebKey{
+		{Kty: "RSA", Kid: "rsa", N: b64(k.rsa.N.Bytes()), E: b64(big.NewInt(int64(k.rsa.E)).Bytes())},
+		{Kty: "EC", Kid: "ec", Crv: "P-256", X: b64(k.ec.X.FillBytes(make([]byte, 32))), Y: b64(k.ec.Y.FillBytes(make([]byte, 32)))},
+	}}
+	data, _ := json.Marshal(set)
+	return data
+}
+
+// sign returns a token with given claims signed by the key.
+func (k *testKeys) sign(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	hdr, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	body, _ := json.Marshal(claims)
+	signed := b64(hdr) + "." + b64(body)
+	hash := sha256.Sum256([]byte(signed))
+	var sig []byte
+	switch alg {
+	case "RS256":
+		var err error
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k.rsa, crypto.SHA256, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, k.ec, hash[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + b64(sig)
+}
+
+func newJWKSServer(t *testing.T, keys *testKeys) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		w.Write(keys.jwks())
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestAuthHandler(t *testing.T) {
+	keys := newTestKeys(t)
+	srv := newJWKSServer(t, keys)
+	now := time.Now().Unix()
+	claims := func(iss, aud, email string, exp int64) map[string]interface{} {
+		return map[string]interface{}{
+			"iss": iss, "aud": aud, "sub": "123", "email": email,
+			"iat": now - 60, "exp": exp,
+		}
+	}
+	valid := claims("https://accounts.google.com", testAudience, "caller@example.com", now+3600)
+	cases := []struct {
+		name   string
+		opt    authOptions
+		header string
+		token  string
+		code   int
+	}{
+		{
+			name:  "valid RS256",
+			token: keys.sign(t, "RS256", "rsa", valid),
+			code:  http.StatusOK,
+		},
+		{
+			name:  "valid ES256",
+			token: keys.sign(t, "ES256", "ec", valid),
+			code:  http.StatusOK,
+		},
+		{
+			name:  "expired",
+			token: keys.sign(t, "RS256", "rsa", claims("https://accounts.google.com", testAudience, "caller@example.com", now-3600)),
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:  "wrong audience",
+			token: keys.sign(t, "RS256", "rsa", claims("https://accounts.google.com", "https://other.example.com", "caller@example.com", now+3600)),
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:  "wrong issuer",
+			token: keys.sign(t, "RS256", "rsa", claims("https://issuer.example.com", testAudience, "caller@example.com", now+3600)),
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:  "unknown key",
+			token: keys.sign(t, "RS256", "other", valid),
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:  "algorithm of another key",
+			token: keys.sign(t, "ES256", "rsa", valid),
+			code:  http.StatusUnauthorized,
+		},
+		{
+			name:  "allowed principal",
+			opt:   authOptions{Principals: []string{"serviceAccount:caller@example.com"}},
+			token: keys.sign(t, "RS256", "rsa", valid),
+			code:  http.StatusOK,
+		},
+		{
+			name:  "disallowed principal",
+			opt:   authOptions{Principals: []string{"user:admin@example.com"}},
+			token: keys.sign(t, "RS256", "rsa", valid),
+			code:  http.StatusForbidden,
+		},
+		{
+			name: "missing bearer",
+			code: http.StatusUnauthorized,
+		},
+		{
+			name:   "not a bearer",
+			header: "Basic dXNlcjpwYXNz",
+			code:   http.StatusUnauthorized,
+		},
+		{
+			name:  "IAP assertion",
+			opt:   authOptions{IAP: true},
+			token: keys.sign(t, "ES256", "ec", claims(iapIssuer, testAudience, "user@example.com", now+3600)),
+			code:  http.StatusOK,
+		},
+		{
+			name:  "IAP with ID token issuer",
+			opt:   authOptions{IAP: true},
+			token: keys.sign(t, "ES256", "ec", valid),
+			code:  http.StatusUnauthorized,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opt := c.opt
+			opt.Audience, opt.JWKSURL = testAudience, srv.URL
+			var got *cloudfunc.Claims
+			h := newAuthHandler(opt, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = cloudfunc.ClaimsFromContext(r.Context())
+			}))
+			req := httptest.NewRequest("GET", "/execute/", nil)
+			switch {
+			case c.header != "":
+				req.Header.Set("Authorization", c.header)
+			case c.token != "" && opt.IAP:
+				req.Header.Set(iapHeader, c.token)
+			case c.token != "":
+				req.Header.Set("Authorization", "Bearer "+c.token)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+			if rec.Code != c.code {
+				t.Fatalf("expected status %d, got %d: %s", c.code, rec.Code, rec.Body.String())
+			}
+			if c.code != http.StatusOK {
+				if got != nil {
+					t.Fatal("handler is called")
+				}
+				return
+			}
+			if got == nil || got.Subject != "123" || len(got.Audience) != 1 || got.Audience[0] != testAudience {
+				t.Fatalf("unexpected claims: %+v", got)
+			}
+		})
+	}
+}
+
+func TestKeyCacheSlowFetch(t *testing.T) {
+	keys := newTestKeys(t)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.Write(keys.jwks())
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c := &keyCache{
+		url:     srv.URL,
+		keys:    map[string]crypto.PublicKey{"cached": &keys.rsa.PublicKey},
+		expires: time.Now().Add(time.Hour),
+	}
+	// an unknown key starts a fetch that blocks
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.get(ctx, "rsa")
+	for {
+		c.mu.Lock()
+		started := c.fetching != nil
+		c.mu.Unlock()
+		if started {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.get(context.Background(), "cached")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cached key is blocked by the fetch")
+	}
+
+	// concurrent lookups wait for the same fetch
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel2()
+	if _, err := c.get(ctx2, "ec"); err != context.DeadlineExceeded {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"net/http"
+	"os"
+	"strings"
+)
+
+type corsOptions struct {
+	Origins []string
+	Methods []string
+	Headers []string
+	MaxAge  string
+}
+
+var cors = corsOptions{
+	Origins: splitList(os.Getenv("CLOUDFUNC_CORS_ORIGINS")),
+	Methods: splitList(os.Getenv("CLOUDFUNC_CORS_METHODS")),
+	Headers: splitList(os.Getenv("CLOUDFUNC_CORS_HEADERS")),
+	MaxAge:  os.Getenv("CLOUDFUNC_CORS_MAX_AGE"),
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func (c corsOptions) enabled() bool {
+	return len(c.Origins) != 0
+}
+
+func (c corsOptions) allowOrigin(origin string) bool {
+	for _, o := range c.Origins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// corsHandler answers CORS preflight requests and sets CORS headers on
+// responses of the wrapped handler.
+type corsHandler struct {
+	opt corsOptions
+	h   http.Handler
+}
+
+func (h corsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		h.h.ServeHTTP(w, r)
+		return
+	}
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	hdr := w.Header()
+	hdr.Add("Vary", "Origin")
+	if !h.opt.allowOrigin(origin) {
+		if preflight {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		h.h.ServeHTTP(w, r)
+		return
+	}
+	hdr.Set("Access-Control-Allow-Origin", origin)
+	if !preflight {
+		h.h.ServeHTTP(w, r)
+		return
+	}
+	if len(h.opt.Methods) != 0 {
+		hdr.Set("Access-Control-Allow-Methods", strings.Join(h.opt.Methods, ", "))
+	} else {
+		hdr.Set("Access-Control-Allow-Methods", r.Header.Get("Access-Control-Request-Method"))
+	}
+	if len(h.opt.Headers) != 0 {
+		hdr.Set("Access-Control-Allow-Headers", strings.Join(h.opt.Headers, ", "))
+	} else if req := r.Header.Get("Access-Control-Request-Headers"); req != "" {
+		hdr.Set("Access-Control-Allow-Headers", req)
+	}
+	if h.opt.MaxAge != "" {
+		hdr.Set("Access-Control-Max-Age", h.opt.MaxAge)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
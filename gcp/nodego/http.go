@@ -5,6 +5,8 @@ import (
 	"net/http"
 	"os"
 	"strings"
+
+	"github.com/nwca/cloudfunc"
 )
 
 var fixRedirects = os.Getenv("CLOUDFUNC_FIX_REDIRECTS") == "true"
@@ -18,6 +20,20 @@ func HandleHTTP(fnc http.HandlerFunc) {
 	http.Handle("/", WithLogger(h))
 }
 
+// userHandler returns a handler that serves all requests to the function,
+// wrapped with CORS handling, authentication and middlewares registered by
+// the user.
+func userHandler() http.Handler {
+	h := cloudfunc.Wrap(http.DefaultServeMux)
+	if auth.enabled() {
+		h = newAuthHandler(auth, h)
+	}
+	if cors.enabled() {
+		h = corsHandler{opt: cors, h: h}
+	}
+	return h
+}
+
 type redirectHandler struct {
 	h http.Handler
 }
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+)
+
+const initRetryDelay = time.Second
+
+var (
+	initTimeout = initTimeoutFromEnv()
+	initRetries = initRetriesFromEnv()
+)
+
+func initTimeoutFromEnv() time.Duration {
+	if d, err := time.ParseDuration(os.Getenv("CLOUDFUNC_INIT_TIMEOUT")); err == nil && d > 0 {
+		return d
+	}
+	return supervisorLogTimeout
+}
+
+// initRetriesFromEnv returns the number of retries of a failed init. Invalid
+// and negative values mean no retries, so init still runs once.
+func initRetriesFromEnv() int {
+	n, err := strconv.Atoi(os.Getenv("CLOUDFUNC_INIT_RETRIES"))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+var loadState struct {
+	once sync.Once
+	err  error
+}
+
+// initOnce runs user init functions on the first call and returns the result
+// on each subsequent call.
+func initOnce() error {
+	loadState.once.Do(func() {
+		loadState.err = runInit()
+	})
+	return loadState.err
+}
+
+func runInit() error {
+	var err error
+	for i := 0; i <= initRetries; i++ {
+		if i > 0 {
+			time.Sleep(initRetryDelay)
+		}
+		if err = initWithTimeout(initTimeout); err == nil {
+			return nil
+		}
+		ErrorLogger.Printf("init failed (attempt %d of %d): %v", i+1, initRetries+1, err)
+	}
+	return err
+}
+
+func initWithTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- cloudfunc.Init(ctx)
+	}()
+	select {
+	case err := <-errc:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("init timed out after %v", timeout)
+	}
+}
+
+func handleLoad(w http.ResponseWriter, r *http.Request) {
+	if err := initOnce(); err != nil {
+		http.Error(w, "User function failed to load: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	fmt.Fprintln(w, "User function is ready")
+}
+
+// buildInfoHeader returns the build info of the function as JSON on /check,
+// since the supervisor expects a plain OK in the body.
+const buildInfoHeader = "X-Cloudfunc-Build"
+
+func handleCheck(w http.ResponseWriter, r *http.Request) {
+	if err := initOnce(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if info, err := json.Marshal(cloudfunc.ReadBuildInfo()); err == nil {
+		w.Header().Set(buildInfoHeader, string(info))
+	}
+	fmt.Fprintln(w, "OK")
+}
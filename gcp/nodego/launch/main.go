@@ -0,0 +1,43 @@
+// Command launch is started by the node shim as main. It resolves secret
+// references in the environment and replaces itself with the function
+// binary, so package init functions of the function see the values.
+//
+// A custom resolver is registered by a package imported by the build, see
+// BuildOptions.SecretResolver.
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+)
+
+// functionBin is the name of the function binary next to the launcher.
+const functionBin = "function"
+
+// resolveTimeout limits the time spent on secrets before the function starts.
+const resolveTimeout = 30 * time.Second
+
+func main() {
+	exe, err := os.Executable()
+	if err != nil {
+		log.Fatalln("cannot find the function binary:", err)
+	}
+	bin := filepath.Join(filepath.Dir(exe), functionBin)
+
+	ctx, cancel := context.WithTimeout(context.Background(), resolveTimeout)
+	err = cloudfunc.ResolveSecrets(ctx)
+	cancel()
+	if err != nil {
+		// references that are left are resolved again by Init,
+		// which fails the load of the function with the error
+		log.Println("cannot resolve secrets:", err)
+	}
+	err = syscall.Exec(bin, append([]string{bin}, os.Args[1:]...), os.Environ())
+	log.Fatalln("cannot start the function:", err)
+}
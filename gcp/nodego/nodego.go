@@ -20,6 +20,7 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
 	"net/http"
@@ -27,6 +28,8 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+
+	"github.com/nwca/cloudfunc"
 )
 
 var fds = flag.String("fds", "", "fd1,fd2,...")
@@ -35,19 +38,18 @@ var fds = flag.String("fds", "", "fd1,fd2,...")
 // execve'd this binary. This binary must have been started by the execer node
 // module for this to work.
 func TakeOver() {
+	log.Println("Function build:", cloudfunc.ReadBuildInfo())
+	checkShimVersion()
 	if len(*fds) == 0 {
 		fmt.Fprintln(os.Stderr, "Required flag fds was not set.")
 		fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
 		flag.PrintDefaults()
 	}
 
-	ready := func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintln(w, "User function is ready")
-	}
-	http.HandleFunc("/load", ready)
-	http.HandleFunc("/check", func(w http.ResponseWriter, r *http.Request) {
-		fmt.Fprintln(w, "OK")
-	})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/load", handleLoad)
+	mux.HandleFunc("/check", handleCheck)
+	mux.Handle("/", userHandler())
 
 	var wg sync.WaitGroup
 	for _, arg := range strings.Split(*fds, ",") {
@@ -67,7 +69,7 @@ func TakeOver() {
 		log.Println("Resuming HTTP server on", l.Addr())
 		wg.Add(1)
 		go func() {
-			log.Println(http.Serve(l, nil))
+			log.Println(http.Serve(l, mux))
 			l.Close()
 			wg.Done()
 		}()
@@ -75,3 +77,20 @@ func TakeOver() {
 
 	wg.Wait()
 }
+
+// checkShimVersion reports if the binary was built for a different shim than
+// the one it was deployed with.
+func checkShimVersion() {
+	want := cloudfunc.ReadBuildInfo().Shim
+	if want == "" {
+		return
+	}
+	data, err := ioutil.ReadFile(codeLocationDir + "/shim.version")
+	if err != nil {
+		ErrorLogger.Println("Cannot read shim version:", err)
+		return
+	}
+	if got := strings.TrimSpace(string(data)); got != want {
+		ErrorLogger.Printf("Shim version mismatch: binary is built for %s, deployed with %s", want, got)
+	}
+}
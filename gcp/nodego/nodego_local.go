@@ -21,13 +21,21 @@ import (
 	"log"
 	"net"
 	"net/http"
+
+	"github.com/nwca/cloudfunc"
 )
 
 var address = flag.String("addr", ":8080", "host and port number")
 
-// TakeOver listens and servers http.DefaultServeMux on the address passed by a
-// command line flag.
+// TakeOver runs user init functions, then listens and servers
+// http.DefaultServeMux wrapped with user middlewares on the address passed by
+// a command line flag.
 func TakeOver() {
+	log.Println("Function build:", cloudfunc.ReadBuildInfo())
+	if err := initOnce(); err != nil {
+		panic(err)
+	}
+
 	lis, err := net.Listen("tcp", *address)
 	if err != nil {
 		panic(err)
@@ -35,7 +43,7 @@ func TakeOver() {
 
 	log.Println("listening on", lis.Addr().String())
 
-	if err := http.Serve(lis, nil); err != nil {
+	if err := http.Serve(lis, userHandler()); err != nil {
 		panic(err)
 	}
 }
@@ -0,0 +1,231 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Action is the kind of a planned change.
+type Action byte
+
+const (
+	ActionAdd    Action = '+'
+	ActionRemove Action = '-'
+	ActionModify Action = '~'
+)
+
+// Change is a planned change of a function setting.
+type Change struct {
+	Action Action
+	// Field is the name of the setting, e.g. "timeout" or "env FOO".
+	Field string
+	Old   string
+	New   string
+	// Sensitive values are not printed.
+	Sensitive bool
+}
+
+func (c Change) String() string {
+	old, nv := strconv.Quote(c.Old), strconv.Quote(c.New)
+	if c.Sensitive {
+		old, nv = "(sensitive)", "(sensitive)"
+	}
+	switch c.Action {
+	case ActionAdd:
+		return fmt.Sprintf("+ %s: %s", c.Field, nv)
+	case ActionRemove:
+		return fmt.Sprintf("- %s: %s", c.Field, old)
+	}
+	return fmt.Sprintf("~ %s: %s -> %s", c.Field, old, nv)
+}
+
+// Plan describes what a deploy would change in a function.
+type Plan struct {
+	Name string
+	// Create is set if the function doesn't exist.
+	Create bool
+	// Source is set if the build hash differs from the deployed one.
+	Source  bool
+	Changes []Change
+}
+
+// Pending reports if the deploy would change the function.
+func (p *Plan) Pending() bool {
+	return p.Create || p.Source || len(p.Changes) != 0
+}
+
+// Print writes a human-readable plan.
+func (p *Plan) Print(w io.Writer) error {
+	var err error
+	printf := func(format string, args ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	switch {
+	case p.Create:
+		printf("function %s will be created\n", p.Name)
+	case !p.Pending():
+		printf("function %s is up to date\n", p.Name)
+		return err
+	default:
+		printf("function %s will be updated\n", p.Name)
+	}
+	if p.Source {
+		printf("  ~ source: changed\n")
+	} else {
+		printf("    source: unchanged\n")
+	}
+	for _, c := range p.Changes {
+		printf("  %s\n", c)
+	}
+	return err
+}
+
+// Plan compares the deployed function with the one Deploy would submit with
+// the same options. The source is compared by the build hash, so opt.Hash must
+// be set. Plan doesn't change anything.
+func (c *Client) Plan(ctx context.Context, name string, tr Trigger, opt *DeployOptions) (*Plan, error) {
+	if opt == nil {
+		opt = &DeployOptions{}
+	}
+	p := &Plan{Name: name}
+	cur, err := c.funcs.GetFunction(ctx, &funcs.GetFunctionRequest{
+		Name: c.functionID(name),
+	})
+	if status.Code(err) == codes.NotFound {
+		p.Create = true
+		cur = &funcs.CloudFunction{Name: c.functionID(name)}
+	} else if err != nil {
+		return nil, err
+	}
+	f := proto.Clone(cur).(*funcs.CloudFunction)
+	if err = c.configure(f, tr, opt); err != nil {
+		return nil, err
+	}
+	p.Source = p.Create || opt.Hash == "" || cur.Labels[HashLabel] != opt.Hash
+
+	diff := func(field, old, nv string) {
+		switch {
+		case old == nv:
+		case old == "":
+			p.Changes = append(p.Changes, Change{Action: ActionAdd, Field: field, New: nv})
+		case nv == "":
+			p.Changes = append(p.Changes, Change{Action: ActionRemove, Field: field, Old: old})
+		default:
+			p.Changes = append(p.Changes, Change{Action: ActionModify, Field: field, Old: old, New: nv})
+		}
+	}
+	diff("trigger", triggerName(cur), triggerName(f))
+	diff("event resource", cur.GetEventTrigger().GetResource(), f.GetEventTrigger().GetResource())
+	diff("runtime", cur.Runtime, f.Runtime)
+	diff("entry point", cur.EntryPoint, f.EntryPoint)
+	diff("memory", formatMemory(cur.AvailableMemoryMb), formatMemory(f.AvailableMemoryMb))
+	diff("timeout", formatTimeout(cur), formatTimeout(f))
+	diff("vpc connector", cur.VpcConnector, f.VpcConnector)
+	diff("ingress", formatIngress(cur.IngressSettings), formatIngress(f.IngressSettings))
+	diff("max instances", formatInt(cur.MaxInstances), formatInt(f.MaxInstances))
+	p.Changes = append(p.Changes, mapChanges("env", cur.EnvironmentVariables, f.EnvironmentVariables, looksLikeSecret)...)
+	p.Changes = append(p.Changes, mapChanges("label", userLabels(cur.Labels), userLabels(f.Labels), nil)...)
+	return p, nil
+}
+
+// mapChanges compares maps by key.
+func mapChanges(field string, old, nv map[string]string, sensitive func(k, v string) bool) []Change {
+	keys := make(map[string]struct{}, len(old)+len(nv))
+	for k := range old {
+		keys[k] = struct{}{}
+	}
+	for k := range nv {
+		keys[k] = struct{}{}
+	}
+	sorted := make([]string, 0, len(keys))
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+	var out []Change
+	for _, k := range sorted {
+		ov, inOld := old[k]
+		v, inNew := nv[k]
+		ch := Change{Field: field + " " + k, Old: ov, New: v}
+		switch {
+		case inOld && inNew && ov == v:
+			continue
+		case !inOld:
+			ch.Action = ActionAdd
+		case !inNew:
+			ch.Action = ActionRemove
+		default:
+			ch.Action = ActionModify
+		}
+		if sensitive != nil {
+			ch.Sensitive = sensitive(k, ov) || sensitive(k, v)
+		}
+		out = append(out, ch)
+	}
+	return out
+}
+
+// userLabels returns labels that are not set by Deploy.
+func userLabels(labels map[string]string) map[string]string {
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if !IsMetadataLabel(k) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func triggerName(f *funcs.CloudFunction) string {
+	if t := f.GetEventTrigger(); t != nil {
+		return t.EventType
+	} else if f.GetHttpsTrigger() != nil {
+		return "http"
+	}
+	return ""
+}
+
+func formatMemory(mb int32) string {
+	if mb == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(mb)) + "MB"
+}
+
+func formatTimeout(f *funcs.CloudFunction) string {
+	if f.Timeout == nil {
+		return ""
+	}
+	d, err := ptypes.Duration(f.Timeout)
+	if err != nil {
+		return f.Timeout.String()
+	}
+	return d.String()
+}
+
+func formatIngress(v funcs.CloudFunction_IngressSettings) string {
+	for name, s := range IngressSettings {
+		if s == v {
+			return name
+		}
+	}
+	return ""
+}
+
+func formatInt(v int32) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.Itoa(int(v))
+}
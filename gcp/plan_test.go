@@ -0,0 +1,133 @@
+package gcp_test
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nwca/cloudfunc/gcp"
+)
+
+func TestPlan(t *testing.T) {
+	_, cli := newTestClient(t)
+	ctx := context.Background()
+	base := func() *gcp.DeployOptions {
+		return &gcp.DeployOptions{
+			NoMetadata: true,
+			Hash:       "h1",
+			Env:        map[string]string{"A": "1", "API_TOKEN": "t1"},
+			Labels:     map[string]string{"team": "web"},
+			Memory:     256,
+		}
+	}
+	err := cli.Deploy(ctx, "hello", gcp.HTTPTrigger{}, bytes.NewReader([]byte("v1")), base())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name    string
+		fnc     string
+		tr      gcp.Trigger
+		opt     func(opt *gcp.DeployOptions)
+		create  bool
+		source  bool
+		changes []gcp.Change
+	}{
+		{
+			name: "no change",
+		},
+		{
+			name:   "source",
+			opt:    func(opt *gcp.DeployOptions) { opt.Hash = "h2" },
+			source: true,
+		},
+		{
+			name:   "no hash",
+			opt:    func(opt *gcp.DeployOptions) { opt.Hash = "" },
+			source: true,
+		},
+		{
+			name: "env",
+			opt: func(opt *gcp.DeployOptions) {
+				opt.Env = map[string]string{"A": "2", "B": "3", "API_TOKEN": "t2"}
+			},
+			changes: []gcp.Change{
+				{Action: gcp.ActionModify, Field: "env A", Old: "1", New: "2"},
+				{Action: gcp.ActionModify, Field: "env API_TOKEN", Old: "t1", New: "t2", Sensitive: true},
+				{Action: gcp.ActionAdd, Field: "env B", New: "3"},
+			},
+		},
+		{
+			name: "env removed",
+			opt:  func(opt *gcp.DeployOptions) { opt.Env = map[string]string{"A": "1"} },
+			changes: []gcp.Change{
+				{Action: gcp.ActionRemove, Field: "env API_TOKEN", Old: "t1", Sensitive: true},
+			},
+		},
+		{
+			name: "trigger",
+			tr:   gcp.TopicTrigger{Topic: "events"},
+			changes: []gcp.Change{
+				{Action: gcp.ActionModify, Field: "trigger", Old: "http", New: "providers/cloud.pubsub/eventTypes/topic.publish"},
+				{Action: gcp.ActionAdd, Field: "event resource", New: "projects/" + testProject + "/topics/events"},
+			},
+		},
+		{
+			name: "settings",
+			opt: func(opt *gcp.DeployOptions) {
+				opt.Memory = 512
+				opt.Timeout = time.Minute
+				opt.Labels = map[string]string{"team": "api"}
+			},
+			changes: []gcp.Change{
+				{Action: gcp.ActionModify, Field: "memory", Old: "256MB", New: "512MB"},
+				{Action: gcp.ActionAdd, Field: "timeout", New: "1m0s"},
+				{Action: gcp.ActionModify, Field: "label team", Old: "web", New: "api"},
+			},
+		},
+		{
+			name:   "new function",
+			fnc:    "other",
+			create: true,
+			source: true,
+			changes: []gcp.Change{
+				{Action: gcp.ActionAdd, Field: "trigger", New: "http"},
+				{Action: gcp.ActionAdd, Field: "entry point", New: "helloWorld"},
+				{Action: gcp.ActionAdd, Field: "memory", New: "256MB"},
+				{Action: gcp.ActionAdd, Field: "env A", New: "1"},
+				{Action: gcp.ActionAdd, Field: "env API_TOKEN", New: "t1", Sensitive: true},
+				{Action: gcp.ActionAdd, Field: "label team", New: "web"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			name, tr, opt := "hello", c.tr, base()
+			if c.fnc != "" {
+				name = c.fnc
+			}
+			if tr == nil {
+				tr = gcp.HTTPTrigger{}
+			}
+			if c.opt != nil {
+				c.opt(opt)
+			}
+			p, err := cli.Plan(ctx, name, tr, opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Create != c.create || p.Source != c.source {
+				t.Errorf("expected create %v and source %v, got %v and %v", c.create, c.source, p.Create, p.Source)
+			}
+			if !reflect.DeepEqual(p.Changes, c.changes) {
+				t.Errorf("unexpected changes:\n%v\nexpected:\n%v", p.Changes, c.changes)
+			}
+			if pending := c.create || c.source || len(c.changes) != 0; p.Pending() != pending {
+				t.Errorf("expected pending %v", pending)
+			}
+		})
+	}
+}
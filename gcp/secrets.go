@@ -0,0 +1,88 @@
+package gcp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/nwca/cloudfunc"
+)
+
+// secretKeyWords are parts of variable names that usually hold secrets.
+var secretKeyWords = []string{
+	"PASSWORD", "PASSWD", "SECRET", "TOKEN", "API_KEY", "APIKEY",
+	"PRIVATE_KEY", "CREDENTIAL", "AUTH_KEY",
+}
+
+// secretValue matches values in well-known secret formats.
+var secretValue = regexp.MustCompile(`^(-----BEGIN [A-Z ]*PRIVATE KEY-----|AKIA[0-9A-Z]{16}$|AIza[0-9A-Za-z_\-]{35}$|gh[pousr]_[0-9A-Za-z]{36}|xox[abpr]-|sk_live_)`)
+
+// looksLikeSecret reports if a plain-text variable probably holds a secret.
+func looksLikeSecret(k, v string) bool {
+	if v == "" || cloudfunc.IsSecretRef(v) || strings.HasPrefix(k, "CLOUDFUNC_") {
+		return false
+	}
+	if secretValue.MatchString(v) {
+		return true
+	}
+	uk := strings.ToUpper(k)
+	for _, w := range secretKeyWords {
+		if strings.Contains(uk, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSecrets validates secret references in the environment and returns
+// sorted names of plain-text variables that look like secrets.
+func CheckSecrets(env map[string]string) ([]string, error) {
+	var plain []string
+	for k, v := range env {
+		if cloudfunc.IsSecretRef(v) {
+			if _, err := cloudfunc.ParseSecretRef(v); err != nil {
+				return nil, fmt.Errorf("%s: %v", k, err)
+			}
+		} else if looksLikeSecret(k, v) {
+			plain = append(plain, k)
+		}
+	}
+	sort.Strings(plain)
+	return plain, nil
+}
+
+// writePlaceholderSecrets writes a placeholder value for each secret referenced
+// by the environment, so the function can run locally without access to
+// Secret Manager. It returns an empty dir if there are no references.
+func writePlaceholderSecrets(env map[string]string) (string, error) {
+	var refs []cloudfunc.SecretRef
+	for _, v := range env {
+		if !cloudfunc.IsSecretRef(v) {
+			continue
+		}
+		ref, err := cloudfunc.ParseSecretRef(v)
+		if err != nil {
+			return "", err
+		}
+		refs = append(refs, ref)
+	}
+	if len(refs) == 0 {
+		return "", nil
+	}
+	dir, err := ioutil.TempDir("", "cloudfunc-secrets-")
+	if err != nil {
+		return "", err
+	}
+	for _, ref := range refs {
+		err = ioutil.WriteFile(filepath.Join(dir, ref.Secret), []byte("placeholder-"+ref.Secret), 0600)
+		if err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
@@ -0,0 +1,98 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/nwca/cloudfunc"
+)
+
+// ServeOptions are optional parameters for Serve.
+type ServeOptions struct {
+	// Addr is the host and port to listen on. Default is :8080.
+	Addr string
+	// SecretsDir is a directory with files named after secrets referenced by
+	// the environment. Secrets are resolved from Secret Manager if not set.
+	SecretsDir string
+}
+
+// Serve builds the function for the host and runs it locally without the
+// node shim until the context is cancelled. Requests are served the same way
+// as by the shim, so HTTP functions are called under /execute/.
+func Serve(ctx context.Context, tr Trigger, opt *BuildOptions, sopt *ServeOptions) error {
+	if opt == nil {
+		opt = &BuildOptions{}
+	}
+	if sopt == nil {
+		sopt = &ServeOptions{}
+	}
+	addr := sopt.Addr
+	if addr == "" {
+		addr = ":8080"
+	}
+	files, err := includedFiles(opt.Include, opt.shim())
+	if err != nil {
+		return err
+	}
+	dir, err := ioutil.TempDir("", "cloudfunc-serve-")
+	if err != nil {
+		return err
+	}
+	log.Println("build dir:", dir)
+	defer os.RemoveAll(dir)
+
+	if err := unpackNodego(dir); err != nil {
+		return fmt.Errorf("cannot unpack template: %v", err)
+	}
+	if err = writeImpl(dir, tr.writeSource); err != nil {
+		return fmt.Errorf("cannot write import: %v", err)
+	}
+	if err = writeResolver(dir, opt.SecretResolver); err != nil {
+		return fmt.Errorf("cannot write secret resolver import: %v", err)
+	}
+	mod := tr.target().Module
+	if mod != nil {
+		if err := writeGoMod(dir, mod); err != nil {
+			return fmt.Errorf("cannot write go.mod: %v", err)
+		}
+	}
+	bin, err := goBuildAll(dir, tr, opt, readStamp(tr.target().Dir), mod != nil, true)
+	if err != nil {
+		return err
+	}
+	if err := linkFiles(dir, files); err != nil {
+		return fmt.Errorf("cannot link included files: %v", err)
+	}
+
+	cmd := exec.CommandContext(ctx, bin, "-addr", addr)
+	cmd.Dir = dir
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	refs := false
+	for k, v := range opt.Env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+		refs = refs || cloudfunc.IsSecretRef(v)
+	}
+	cmd.Env = append(cmd.Env, "CODE_LOCATION="+dir)
+	if sopt.SecretsDir != "" {
+		sdir, err := filepath.Abs(sopt.SecretsDir)
+		if err != nil {
+			return err
+		}
+		cmd.Env = append(cmd.Env, cloudfunc.SecretsDirEnv+"="+sdir)
+	} else if refs {
+		log.Println("secrets will be resolved from Secret Manager, use a secrets dir to read them from files")
+	}
+	log.Printf("serving function on %s", addr)
+	err = cmd.Run()
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
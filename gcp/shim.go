@@ -0,0 +1,260 @@
+package gcp
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ShimVersionFile is the name of the archive file that records the version of
+// the runtime shim.
+const ShimVersionFile = "shim.version"
+
+// defaultShimTar is the node wrapper that starts the function binary.
+//
+//go:embed function.tar
+var defaultShimTar []byte
+
+// nodegoFS holds the sources of the Go side of the runtime.
+//
+//go:embed nodego
+var nodegoFS embed.FS
+
+// requiredShimFiles must be present in every shim template.
+var requiredShimFiles = []string{
+	"index.js",
+	"package.json",
+	"node_modules/execer/index.js",
+	"node_modules/execer/build/Release/execer.node",
+}
+
+type shimFile struct {
+	Name string
+	Mode os.FileMode
+	Data []byte
+}
+
+// Shim is a template of the node wrapper that starts the function binary.
+type Shim struct {
+	name  string
+	files []shimFile
+}
+
+// DefaultShim returns the shim template embedded into cloudfunc.
+func DefaultShim() *Shim {
+	s, err := readShimTar("default", bytes.NewReader(defaultShimTar))
+	if err != nil {
+		panic(fmt.Errorf("invalid default shim: %v", err))
+	}
+	return s
+}
+
+// LoadShim loads a shim template from a directory or a tar archive,
+// optionally compressed with gzip, and validates it.
+func LoadShim(p string) (*Shim, error) {
+	st, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Base(p)
+	for _, ext := range []string{".tgz", ".gz", ".tar"} {
+		name = strings.TrimSuffix(name, ext)
+	}
+	var s *Shim
+	if st.IsDir() {
+		s, err = readShimDir(name, p)
+	} else {
+		s, err = readShimFile(name, p)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read shim %q: %v", p, err)
+	}
+	if err = s.validate(); err != nil {
+		return nil, fmt.Errorf("invalid shim %q: %v", p, err)
+	}
+	return s, nil
+}
+
+func readShimFile(name, p string) (*Shim, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	br := bufio.NewReader(f)
+	var r io.Reader = br
+	if magic, _ := br.Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		r = zr
+	}
+	return readShimTar(name, r)
+}
+
+func readShimTar(name string, r io.Reader) (*Shim, error) {
+	s := &Shim{name: name}
+	tr := tar.NewReader(r)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		fi := h.FileInfo()
+		if !fi.Mode().IsRegular() && !fi.IsDir() {
+			continue
+		}
+		f := shimFile{Name: path.Clean(strings.TrimPrefix(h.Name, "./")), Mode: fi.Mode()}
+		if f.Name == "." {
+			continue
+		} else if fi.IsDir() {
+			f.Name += "/"
+		} else if f.Data, err = ioutil.ReadAll(tr); err != nil {
+			return nil, err
+		}
+		s.files = append(s.files, f)
+	}
+	return s, nil
+}
+
+func readShimDir(name, dir string) (*Shim, error) {
+	s := &Shim{name: name}
+	err := filepath.Walk(dir, func(p string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		f := shimFile{Name: filepath.ToSlash(rel), Mode: fi.Mode()}
+		switch {
+		case fi.IsDir():
+			f.Name += "/"
+		case fi.Mode().IsRegular():
+			if f.Data, err = ioutil.ReadFile(p); err != nil {
+				return err
+			}
+		default:
+			return nil
+		}
+		s.files = append(s.files, f)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(s.files, func(i, j int) bool {
+		return s.files[i].Name < s.files[j].Name
+	})
+	return s, nil
+}
+
+// validate checks that the shim has all required files and does not
+// overwrite files added by the build.
+func (s *Shim) validate() error {
+	have := make(map[string]bool, len(s.files))
+	for _, f := range s.files {
+		have[f.Name] = true
+		switch strings.TrimSuffix(f.Name, "/") {
+		case "main", functionBin, "env.js", ShimVersionFile:
+			return fmt.Errorf("%q is added by the build and cannot be a part of the shim", f.Name)
+		}
+		if f.Name == ".." || strings.HasPrefix(f.Name, "../") || path.IsAbs(f.Name) {
+			return fmt.Errorf("invalid file name: %q", f.Name)
+		}
+	}
+	var missing []string
+	for _, name := range requiredShimFiles {
+		if !have[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("missing required files: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// has checks if the shim contains a file with a given name.
+func (s *Shim) has(name string) bool {
+	if s == nil {
+		return false
+	}
+	for _, f := range s.files {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Version returns the version string of the shim: its name and a hash of
+// its files, along with the Go runtime sources.
+func (s *Shim) Version() string {
+	h := sha256.New()
+	for _, f := range s.files {
+		fmt.Fprintf(h, "shim %q %v %d\n", f.Name, f.Mode.IsDir(), len(f.Data))
+		h.Write(f.Data)
+	}
+	names, _ := nodegoFiles()
+	for _, name := range names {
+		data, _ := nodegoFS.ReadFile(path.Join("nodego", name))
+		fmt.Fprintf(h, "nodego %q %d\n", name, len(data))
+		h.Write(data)
+	}
+	return s.name + "@" + hex.EncodeToString(h.Sum(nil)[:6])
+}
+
+// nodegoFiles lists the sources of the runtime and its launcher, without
+// tests. Names are relative to the template directory.
+func nodegoFiles() ([]string, error) {
+	var names []string
+	err := fs.WalkDir(nodegoFS, "nodego", func(p string, e fs.DirEntry, err error) error {
+		if err != nil || e.IsDir() || strings.HasSuffix(p, "_test.go") {
+			return err
+		}
+		names = append(names, strings.TrimPrefix(p, "nodego/"))
+		return nil
+	})
+	return names, err
+}
+
+// unpackNodego writes the Go sources of the runtime to the directory.
+func unpackNodego(dir string) error {
+	names, err := nodegoFiles()
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		data, err := nodegoFS.ReadFile(path.Join("nodego", name))
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(dst, data, 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
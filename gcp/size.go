@@ -0,0 +1,129 @@
+package gcp
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Size limits of the cloud functions platform.
+const (
+	// MaxArchiveSize is the maximal size of the zip archive uploaded to the platform.
+	MaxArchiveSize = 100 << 20
+	// MaxUnpackedSize is the maximal size of the function source after unpacking.
+	MaxUnpackedSize = 500 << 20
+)
+
+// ArchiveSize describes the size of the function archive.
+type ArchiveSize struct {
+	// Binary is the size of the function binary and its launcher.
+	Binary int64
+	// Shim is the unpacked size of the runtime shim files.
+	Shim int64
+	// Extra is the size of the environment and included files.
+	Extra int64
+	// Compressed is the size of the zip archive.
+	Compressed int64
+}
+
+// Unpacked returns the total size of all files in the archive.
+func (s ArchiveSize) Unpacked() int64 {
+	return s.Binary + s.Shim + s.Extra
+}
+
+func (s ArchiveSize) String() string {
+	var parts []string
+	if s.Binary != 0 {
+		parts = append(parts, "binary "+FormatSize(s.Binary))
+	}
+	if s.Shim != 0 {
+		parts = append(parts, "shim "+FormatSize(s.Shim))
+	}
+	if s.Extra != 0 {
+		parts = append(parts, "extra "+FormatSize(s.Extra))
+	}
+	parts = append(parts,
+		"unpacked "+FormatSize(s.Unpacked()),
+		"compressed "+FormatSize(s.Compressed),
+	)
+	return strings.Join(parts, ", ")
+}
+
+// Check verifies the archive against the platform limits and an optional
+// budget for the compressed size.
+func (s ArchiveSize) Check(budget int64) error {
+	if s.Compressed > MaxArchiveSize {
+		return fmt.Errorf("archive is too large: %s, the limit is %s", FormatSize(s.Compressed), FormatSize(MaxArchiveSize))
+	}
+	if s.Unpacked() > MaxUnpackedSize {
+		return fmt.Errorf("unpacked archive is too large: %s, the limit is %s", FormatSize(s.Unpacked()), FormatSize(MaxUnpackedSize))
+	}
+	if budget > 0 && s.Compressed > budget {
+		return fmt.Errorf("archive exceeds the size budget: %s, the budget is %s", FormatSize(s.Compressed), FormatSize(budget))
+	}
+	return nil
+}
+
+// ReadArchiveSize reads sizes of files in an existing function archive.
+func ReadArchiveSize(r io.ReaderAt, size int64) (ArchiveSize, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return ArchiveSize{}, err
+	}
+	s := ArchiveSize{Compressed: size}
+	for _, f := range zr.File {
+		n := int64(f.UncompressedSize64)
+		switch {
+		case f.Name == "main" || f.Name == functionBin:
+			s.Binary += n
+		case f.Name == "env.js":
+			s.Extra += n
+		case isReserved(f.Name):
+			s.Shim += n
+		default:
+			s.Extra += n
+		}
+	}
+	return s, nil
+}
+
+// CheckArchiveFile verifies the size of an archive file. See ArchiveSize.Check.
+func CheckArchiveFile(f *os.File, budget int64) error {
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	s, err := ReadArchiveSize(f, st.Size())
+	if err != nil {
+		return fmt.Errorf("cannot read archive: %v", err)
+	}
+	return s.Check(budget)
+}
+
+// FormatSize formats a size in bytes for humans.
+func FormatSize(n int64) string {
+	const unit = 1 << 10
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit && exp < 2; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMG"[exp])
+}
+
+// countWriter counts bytes written to the underlying writer.
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,337 @@
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nwca/cloudfunc"
+)
+
+const (
+	smokeFuncName = "cloudfunc-smoke"
+	smokeProject  = "cloudfunc-smoke"
+	// smokeTimeout limits each step of the smoke test.
+	smokeTimeout = time.Minute
+	// smokeLogDelay gives the function time to flush logs to the supervisor.
+	smokeLogDelay = 200 * time.Millisecond
+)
+
+// SmokeResult is the result of a synthetic request sent to the function.
+type SmokeResult struct {
+	// Status is the HTTP status code of the response.
+	Status int
+	// Latency of the request, not including the cold start.
+	Latency time.Duration
+	// Logs of the function, including its standard output.
+	Logs []string
+}
+
+// fakeSupervisor collects logs sent by the function instance.
+type fakeSupervisor struct {
+	mu     sync.Mutex
+	logs   []string
+	killed bool
+}
+
+func (s *fakeSupervisor) addLog(line string) {
+	line = strings.TrimRight(line, "\n")
+	if line == "" {
+		return
+	}
+	s.mu.Lock()
+	s.logs = append(s.logs, line)
+	s.mu.Unlock()
+}
+
+func (s *fakeSupervisor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	switch r.URL.Path {
+	case "/_ah/log":
+		var batch struct {
+			Entries []struct {
+				TextPayload string
+				Severity    string
+			}
+		}
+		if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, e := range batch.Entries {
+			sev := e.Severity
+			if sev != "" {
+				sev = sev[:1]
+			}
+			s.addLog("[" + sev + "] " + e.TextPayload)
+		}
+	case "/_ah/kill":
+		s.mu.Lock()
+		s.killed = true
+		s.mu.Unlock()
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Write implements io.Writer for the output of the function process.
+func (s *fakeSupervisor) Write(p []byte) (int, error) {
+	for _, line := range strings.Split(string(p), "\n") {
+		s.addLog(line)
+	}
+	return len(p), nil
+}
+
+// smokeTest starts the function binary the same way as the node shim does,
+// waits for it to load and sends a single request matching the trigger.
+// Included files must be available in codeDir.
+func smokeTest(bin, codeDir string, tr Trigger, env map[string]string) (*SmokeResult, error) {
+	if runtime.GOOS != "linux" || runtime.GOARCH != "amd64" {
+		return nil, fmt.Errorf("smoke test requires linux/amd64 host, got %s/%s", runtime.GOOS, runtime.GOARCH)
+	}
+	sup := &fakeSupervisor{}
+	sl, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer sl.Close()
+	go http.Serve(sl, sup)
+
+	fl, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	defer fl.Close()
+	lf, err := fl.(*net.TCPListener).File()
+	if err != nil {
+		return nil, err
+	}
+	defer lf.Close()
+
+	cmd := exec.Command(bin, "-fds", "3")
+	cmd.Dir = codeDir
+	cmd.ExtraFiles = []*os.File{lf}
+	cmd.Stdout = sup
+	cmd.Stderr = sup
+	cmd.Env = os.Environ()
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, k+"="+v)
+	}
+	secrets, err := writePlaceholderSecrets(env)
+	if err != nil {
+		return nil, fmt.Errorf("cannot write secrets: %v", err)
+	} else if secrets != "" {
+		defer os.RemoveAll(secrets)
+		cmd.Env = append(cmd.Env, cloudfunc.SecretsDirEnv+"="+secrets)
+	}
+	_, supPort, _ := net.SplitHostPort(sl.Addr().String())
+	cmd.Env = append(cmd.Env,
+		"CODE_LOCATION="+codeDir,
+		"ENTRY_POINT=helloWorld",
+		"FUNCTION_NAME="+smokeFuncName,
+		"FUNCTION_TRIGGER_TYPE="+tr.triggerType(),
+		"FUNCTION_TIMEOUT_SEC="+fmt.Sprint(int(smokeTimeout/time.Second)),
+		"SUPERVISOR_HOSTNAME=127.0.0.1",
+		"SUPERVISOR_INTERNAL_PORT="+supPort,
+	)
+	if err = cmd.Start(); err != nil {
+		return nil, err
+	}
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+	defer func() {
+		cmd.Process.Kill()
+		<-exited
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case err := <-exited:
+			exited <- err
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	res := &SmokeResult{}
+	finish := func(err error) (*SmokeResult, error) {
+		time.Sleep(smokeLogDelay)
+		sup.mu.Lock()
+		defer sup.mu.Unlock()
+		res.Logs = append([]string{}, sup.logs...)
+		if err == nil && sup.killed {
+			err = fmt.Errorf("function asked the supervisor to kill the instance")
+		}
+		return res, err
+	}
+	base := "http://" + fl.Addr().String()
+	for _, path := range []string{"/load", "/check"} {
+		req, err := http.NewRequest("GET", base+path, nil)
+		if err != nil {
+			return finish(err)
+		}
+		resp, _, err := smokeDo(ctx, req)
+		if err != nil {
+			return finish(fmt.Errorf("%s failed: %v", path, err))
+		} else if resp.StatusCode != http.StatusOK {
+			return finish(fmt.Errorf("%s failed: %s", path, resp.Status))
+		}
+	}
+	req, err := tr.smokeRequest(base)
+	if err != nil {
+		return finish(err)
+	}
+	resp, dt, err := smokeDo(ctx, req)
+	if err != nil {
+		return finish(fmt.Errorf("request failed: %v", err))
+	}
+	res.Status, res.Latency = resp.StatusCode, dt
+	if resp.StatusCode >= 500 {
+		return finish(fmt.Errorf("request failed: %s", resp.Status))
+	}
+	return finish(nil)
+}
+
+// smokeDo sends the request and returns the response with the body already
+// read, and the latency of the request.
+func smokeDo(ctx context.Context, req *http.Request) (*http.Response, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, smokeTimeout)
+	defer cancel()
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() == context.Canceled {
+			err = fmt.Errorf("function exited")
+		}
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+	_, err = io.Copy(ioutil.Discard, resp.Body)
+	return resp, time.Since(start), err
+}
+
+// linkFiles makes included files available in the directory under their
+// names in the archive.
+func linkFiles(dir string, files []archiveFile) error {
+	for _, f := range files {
+		src, err := filepath.Abs(f.Path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err = os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+			return err
+		}
+		if err = os.Symlink(src, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func logSmokeResult(res *SmokeResult) {
+	if res == nil {
+		return
+	}
+	for _, line := range res.Logs {
+		log.Println("smoke:", line)
+	}
+	if res.Status != 0 {
+		log.Printf("smoke test: status %d, latency %v", res.Status, res.Latency)
+	}
+}
+
+func newEventRequest(url string, ctx eventContext, data interface{}) (*http.Request, error) {
+	body, err := json.Marshal(struct {
+		Ctx  eventContext `json:"context"`
+		Data interface{}  `json:"data"`
+	}{ctx, data})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return req, nil
+}
+
+// eventContext is the context of an event delivered to the function.
+type eventContext struct {
+	EventID   string `json:"eventId"`
+	EventType string `json:"eventType"`
+	Timestamp string `json:"timestamp"`
+	Resource  struct {
+		Service string `json:"service"`
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+	} `json:"resource"`
+}
+
+func newEventContext(typ, service, name, rtype string) eventContext {
+	var c eventContext
+	c.EventID = "cloudfunc-smoke-1"
+	c.EventType = typ
+	c.Timestamp = time.Now().UTC().Format(time.RFC3339Nano)
+	c.Resource.Service = service
+	c.Resource.Name = name
+	c.Resource.Type = rtype
+	return c
+}
+
+func (t HTTPTrigger) triggerType() string { return "HTTP_TRIGGER" }
+
+func (t HTTPTrigger) smokeRequest(base string) (*http.Request, error) {
+	return http.NewRequest("GET", base+"/execute/", nil)
+}
+
+func (t TopicTrigger) triggerType() string { return "CLOUD_PUBSUB_TRIGGER" }
+
+func (t TopicTrigger) smokeRequest(base string) (*http.Request, error) {
+	topic := "projects/" + smokeProject + "/topics/" + t.Topic
+	ctx := newEventContext("google.pubsub.topic.publish", "pubsub.googleapis.com",
+		topic, "type.googleapis.com/google.pubsub.v1.PubsubMessage")
+	data := map[string]interface{}{
+		"@type":      "type.googleapis.com/google.pubsub.v1.PubsubMessage",
+		"attributes": map[string]string{"cloudfunc": "smoke"},
+		"data":       []byte("cloudfunc smoke test"),
+	}
+	return newEventRequest(base+"/execute/_ah/push-handlers/pubsub/"+topic, ctx, data)
+}
+
+func (t StorageTrigger) triggerType() string { return "CLOUD_STORAGE_TRIGGER" }
+
+func (t StorageTrigger) smokeRequest(base string) (*http.Request, error) {
+	const name = "cloudfunc-smoke.txt"
+	now := time.Now().UTC().Format(time.RFC3339)
+	ctx := newEventContext(string(StorageFinalize), "storage.googleapis.com",
+		"projects/_/buckets/"+t.Bucket+"/objects/"+name, "storage#object")
+	data := map[string]interface{}{
+		"kind":        "storage#object",
+		"bucket":      t.Bucket,
+		"name":        name,
+		"contentType": "text/plain",
+		"size":        "0",
+		"timeCreated": now,
+		"updated":     now,
+	}
+	return newEventRequest(base+"/execute/_ah/push-handlers/pubsub/projects/"+smokeProject+"/topics/cloud-functions-smoke", ctx, data)
+}
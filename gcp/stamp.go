@@ -0,0 +1,116 @@
+package gcp
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// stampPkg is the package that holds build information in the function binary.
+const stampPkg = "github.com/nwca/cloudfunc"
+
+// Version returns the version of cloudfunc.
+func Version() string {
+	if bi, ok := debug.ReadBuildInfo(); ok && bi.Main.Version != "" {
+		return bi.Main.Version
+	}
+	return "(devel)"
+}
+
+// stamp is the version information injected into the function binary.
+type stamp struct {
+	Commit  string
+	Dirty   bool
+	Time    time.Time
+	Version string
+	Shim    string
+}
+
+func gitOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	return string(bytes.TrimSpace(out)), err
+}
+
+// readStamp collects version information for the sources in dir.
+//
+// The build time is taken from SOURCE_DATE_EPOCH, or from the commit if there
+// are no local changes, so that clean builds stay reproducible.
+func readStamp(dir string) stamp {
+	s := stamp{Version: Version()}
+	var commitTime time.Time
+	if commit, err := gitOutput(dir, "rev-parse", "HEAD"); err == nil {
+		s.Commit = commit
+		st, _ := gitOutput(dir, "status", "--porcelain")
+		s.Dirty = st != ""
+		if ts, err := gitOutput(dir, "log", "-1", "--format=%ct"); err == nil {
+			if sec, err := strconv.ParseInt(ts, 10, 64); err == nil {
+				commitTime = time.Unix(sec, 0)
+			}
+		}
+	}
+	if sec, err := strconv.ParseInt(os.Getenv("SOURCE_DATE_EPOCH"), 10, 64); err == nil {
+		s.Time = time.Unix(sec, 0)
+	} else if s.Commit != "" && !s.Dirty {
+		s.Time = commitTime
+	} else {
+		s.Time = time.Now()
+	}
+	return s
+}
+
+// SourceCommit returns the git commit of the function sources, with a
+// "-dirty" suffix if there are local changes. It returns an empty string if
+// the sources are not in a git repository.
+func SourceCommit(tr Trigger) string {
+	dir := tr.target().Dir
+	if dir == "" {
+		return ""
+	}
+	s := readStamp(dir)
+	if s.Dirty {
+		return s.Commit + "-dirty"
+	}
+	return s.Commit
+}
+
+// SourceBranch returns the git branch of the function sources. It returns an
+// empty string if the sources are not in a git repository or HEAD is detached.
+func SourceBranch(tr Trigger) string {
+	dir := tr.target().Dir
+	if dir == "" {
+		return ""
+	}
+	branch, err := gitOutput(dir, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil || branch == "HEAD" {
+		return ""
+	}
+	return branch
+}
+
+// ldflags returns linker flags that set build information variables.
+func (s stamp) ldflags() string {
+	vars := []struct {
+		name, val string
+	}{
+		{"buildCommit", s.Commit},
+		{"buildDirty", strconv.FormatBool(s.Dirty)},
+		{"buildTime", s.Time.UTC().Format(time.RFC3339)},
+		{"buildVersion", s.Version},
+		{"buildShim", s.Shim},
+	}
+	var flags []string
+	for _, v := range vars {
+		if v.val == "" {
+			continue
+		}
+		flags = append(flags, fmt.Sprintf("-X '%s.%s=%s'", stampPkg, v.name, v.val))
+	}
+	return strings.Join(flags, " ")
+}
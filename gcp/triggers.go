@@ -3,40 +3,48 @@ package gcp
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 
-	funcs "google.golang.org/genproto/googleapis/cloud/functions/v1beta2"
+	funcs "cloud.google.com/go/functions/apiv1/functionspb"
 )
 
 func ParseTarget(path string) (Target, error) {
-	var err error
-	path, err = toPackage(path)
+	pkg, fnc := path, ""
+	if base := filepath.Base(path); base != "." && base != ".." {
+		if i := strings.LastIndex(base, "."); i >= 0 {
+			fnc = base[i+1:]
+			pkg = strings.TrimSuffix(path, "."+fnc)
+		}
+	}
+	p, err := listPackage(pkg)
 	if err != nil {
 		return Target{}, err
 	}
-	base := filepath.Base(path)
-	i := strings.LastIndex(base, ".")
-	if i < 0 {
-		return Target{Package: path}, nil
-	}
-	fnc := base[i+1:]
-	pkg := strings.TrimSuffix(path, "."+fnc)
 	return Target{
-		Func: fnc, Package: pkg,
+		Func: fnc, Package: p.ImportPath, Dir: p.Dir, Module: p.Module,
 	}, nil
 }
 
 type Trigger interface {
+	target() Target
 	writeSource(w io.Writer) error
+	writeNative(w io.Writer) error
 	buildTags() []string
 	gcloudArgs() []string
 	setOn(proj string, f *funcs.CloudFunction)
+	triggerType() string
+	smokeRequest(base string) (*http.Request, error)
 }
 
 type Target struct {
 	Package string
 	Func    string
+	// Dir is the source directory of the package.
+	Dir string
+	// Module that provides the package. It is nil in GOPATH mode.
+	Module *Module
 }
 
 func (t Target) target() Target {
@@ -71,13 +79,19 @@ func init(){
 
 func (t HTTPTrigger) setOn(proj string, f *funcs.CloudFunction) {
 	f.Trigger = &funcs.CloudFunction_HttpsTrigger{
-		HttpsTrigger: &funcs.HTTPSTrigger{},
+		HttpsTrigger: &funcs.HttpsTrigger{},
 	}
 }
 func (t HTTPTrigger) gcloudArgs() []string {
 	return []string{"--trigger-http"}
 }
 
+// Event types set by triggers. See FunctionTrigger.
+const (
+	topicEventType   = "providers/cloud.pubsub/eventTypes/topic.publish"
+	storageEventType = "providers/cloud.storage/eventTypes/object.change"
+)
+
 type TopicTrigger struct {
 	Target
 	Topic string
@@ -100,7 +114,7 @@ func init(){
 func (t TopicTrigger) setOn(proj string, f *funcs.CloudFunction) {
 	f.Trigger = &funcs.CloudFunction_EventTrigger{
 		EventTrigger: &funcs.EventTrigger{
-			EventType: "providers/cloud.pubsub/eventTypes/topic.publish",
+			EventType: topicEventType,
 			Resource:  "projects/" + proj + "/topics/" + t.Topic,
 		},
 	}
@@ -110,28 +124,6 @@ func (t TopicTrigger) gcloudArgs() []string {
 	return []string{"--trigger-topic", t.Topic}
 }
 
-func toPackage(pkg string) (string, error) {
-	if !strings.HasPrefix(pkg, ".") && !strings.HasPrefix(pkg, "/") {
-		return pkg, nil
-	}
-	abs, err := filepath.Abs(pkg)
-	if err != nil {
-		return pkg, err
-	}
-	gopath, err := goPath()
-	if err != nil {
-		return pkg, err
-	}
-	for _, pref := range strings.Split(gopath, ":") {
-		if strings.HasPrefix(abs, pref) {
-			pkg = strings.TrimPrefix(abs, filepath.Join(pref, "src"))
-			pkg = strings.Trim(pkg, "/")
-			break
-		}
-	}
-	return pkg, nil
-}
-
 type StorageEvent string
 
 const (
@@ -165,7 +157,7 @@ func init(){
 func (t StorageTrigger) setOn(proj string, f *funcs.CloudFunction) {
 	f.Trigger = &funcs.CloudFunction_EventTrigger{
 		EventTrigger: &funcs.EventTrigger{
-			EventType: "providers/cloud.storage/eventTypes/object.change",
+			EventType: storageEventType,
 			Resource:  "projects/" + proj + "/buckets/" + t.Bucket,
 		},
 	}
@@ -0,0 +1,221 @@
+package cloudfunc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// SecretPrefix marks environment variables that reference a secret instead
+// of holding a value, e.g. secret://projects/p/secrets/db-pass/versions/latest.
+const SecretPrefix = "secret://"
+
+// SecretsDirEnv is the environment variable with a directory used by
+// FileSecrets instead of Secret Manager, for functions that run locally.
+const SecretsDirEnv = "CLOUDFUNC_SECRETS_DIR"
+
+// SecretRef is a reference to a version of a Secret Manager secret.
+type SecretRef struct {
+	Project string
+	Secret  string
+	Version string
+}
+
+// Name returns the resource name of the secret version.
+func (r SecretRef) Name() string {
+	return "projects/" + r.Project + "/secrets/" + r.Secret + "/versions/" + r.Version
+}
+
+// String returns the reference as an environment variable value.
+func (r SecretRef) String() string {
+	return SecretPrefix + r.Name()
+}
+
+// IsSecretRef reports if the value of an environment variable is a secret reference.
+func IsSecretRef(v string) bool {
+	return strings.HasPrefix(v, SecretPrefix)
+}
+
+// ParseSecretRef parses a secret reference. The version defaults to latest.
+func ParseSecretRef(v string) (SecretRef, error) {
+	if !IsSecretRef(v) {
+		return SecretRef{}, fmt.Errorf("secret reference must start with %s", SecretPrefix)
+	}
+	p := strings.Split(strings.TrimPrefix(v, SecretPrefix), "/")
+	if (len(p) != 4 && len(p) != 6) || p[0] != "projects" || p[2] != "secrets" ||
+		(len(p) == 6 && p[4] != "versions") {
+		return SecretRef{}, fmt.Errorf("invalid secret reference %q, expected %sprojects/<project>/secrets/<secret>[/versions/<version>]", v, SecretPrefix)
+	}
+	ref := SecretRef{Project: p[1], Secret: p[3], Version: "latest"}
+	if len(p) == 6 {
+		ref.Version = p[5]
+	}
+	if ref.Project == "" || ref.Version == "" || !validSecretID(ref.Secret) {
+		return SecretRef{}, fmt.Errorf("invalid secret reference %q", v)
+	}
+	return ref, nil
+}
+
+func validSecretID(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
+
+// SecretResolver returns values of referenced secrets.
+type SecretResolver interface {
+	ResolveSecret(ctx context.Context, ref SecretRef) (string, error)
+}
+
+// SecretResolverFunc is a function that implements SecretResolver.
+type SecretResolverFunc func(ctx context.Context, ref SecretRef) (string, error)
+
+// ResolveSecret implements SecretResolver.
+func (f SecretResolverFunc) ResolveSecret(ctx context.Context, ref SecretRef) (string, error) {
+	return f(ctx, ref)
+}
+
+var (
+	secretMu       sync.Mutex
+	secretResolver SecretResolver
+)
+
+// SetSecretResolver replaces the resolver of secret references. Secrets are
+// resolved before the function binary starts, so it must be called from the
+// init of the package set as the secret resolver of the build (see
+// gcp.BuildOptions). On native runtimes, secrets are resolved by Init and it
+// can be called from the init of the function package.
+//
+// By default, secrets are read from files in the directory set by
+// CLOUDFUNC_SECRETS_DIR, or from Secret Manager if it's not set.
+func SetSecretResolver(r SecretResolver) {
+	secretMu.Lock()
+	secretResolver = r
+	secretMu.Unlock()
+}
+
+func defaultSecretResolver() SecretResolver {
+	if dir := os.Getenv(SecretsDirEnv); dir != "" {
+		return FileSecrets(dir)
+	}
+	return SecretManager(nil)
+}
+
+// ResolveSecrets replaces environment variables that reference secrets with
+// the values of the secrets. Variables that were resolved are not resolved
+// again by subsequent calls.
+//
+// ResolveSecrets is called by the launcher before the function binary starts,
+// and by Init before any init functions, for references that are left.
+func ResolveSecrets(ctx context.Context) error {
+	secretMu.Lock()
+	defer secretMu.Unlock()
+	r := secretResolver
+	for _, kv := range os.Environ() {
+		i := strings.IndexByte(kv, '=')
+		if i < 0 || !IsSecretRef(kv[i+1:]) {
+			continue
+		}
+		k := kv[:i]
+		ref, err := ParseSecretRef(kv[i+1:])
+		if err != nil {
+			return fmt.Errorf("cannot resolve %s: %v", k, err)
+		}
+		if r == nil {
+			r = defaultSecretResolver()
+			secretResolver = r
+		}
+		v, err := r.ResolveSecret(ctx, ref)
+		if err != nil {
+			return fmt.Errorf("cannot resolve %s: %v", k, err)
+		}
+		if err = os.Setenv(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FileSecrets returns a resolver that reads each secret from a file in the
+// directory named after the secret. The project and version are ignored,
+// and a trailing newline is removed.
+func FileSecrets(dir string) SecretResolver {
+	return SecretResolverFunc(func(ctx context.Context, ref SecretRef) (string, error) {
+		data, err := ioutil.ReadFile(filepath.Join(dir, ref.Secret))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"), nil
+	})
+}
+
+const (
+	metadataTokenURL = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+	secretManagerURL = "https://secretmanager.googleapis.com/v1/"
+)
+
+// SecretManager returns a resolver that accesses secrets in Secret Manager
+// with the service account of the function. If cli is nil,
+// http.DefaultClient is used.
+func SecretManager(cli *http.Client) SecretResolver {
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+	return SecretResolverFunc(func(ctx context.Context, ref SecretRef) (string, error) {
+		var tok struct {
+			AccessToken string `json:"access_token"`
+		}
+		req, err := http.NewRequest("GET", metadataTokenURL, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+		if err = getJSON(ctx, cli, req, &tok); err != nil {
+			return "", fmt.Errorf("cannot get access token: %v", err)
+		}
+		req, err = http.NewRequest("GET", secretManagerURL+ref.Name()+":access", nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Authorization", "Bearer "+tok.AccessToken)
+		var resp struct {
+			Payload struct {
+				Data string `json:"data"`
+			} `json:"payload"`
+		}
+		if err = getJSON(ctx, cli, req, &resp); err != nil {
+			return "", fmt.Errorf("cannot access %s: %v", ref.Name(), err)
+		}
+		data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+		if err != nil {
+			return "", fmt.Errorf("cannot decode %s: %v", ref.Name(), err)
+		}
+		return string(data), nil
+	})
+}
+
+func getJSON(ctx context.Context, cli *http.Client, req *http.Request, out interface{}) error {
+	resp, err := cli.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,153 @@
+package cloudfunc
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseSecretRef(t *testing.T) {
+	cases := []struct {
+		in   string
+		ref  SecretRef
+		fail bool
+	}{
+		{in: "secret://projects/p/secrets/db-pass", ref: SecretRef{Project: "p", Secret: "db-pass", Version: "latest"}},
+		{in: "secret://projects/p/secrets/db_pass/versions/3", ref: SecretRef{Project: "p", Secret: "db_pass", Version: "3"}},
+		{in: "secret://projects/p/secrets/s/versions/latest", ref: SecretRef{Project: "p", Secret: "s", Version: "latest"}},
+		{in: "projects/p/secrets/s", fail: true},
+		{in: "secret://", fail: true},
+		{in: "secret://projects/p/secrets", fail: true},
+		{in: "secret://projects//secrets/s", fail: true},
+		{in: "secret://projects/p/secrets/", fail: true},
+		{in: "secret://projects/p/secrets/a.b", fail: true},
+		{in: "secret://projects/p/secrets/s/versions/", fail: true},
+		{in: "secret://projects/p/secrets/s/version/1", fail: true},
+		{in: "secret://projects/p/topics/s", fail: true},
+		{in: "secret://projects/p/secrets/s/versions/1/x", fail: true},
+	}
+	for _, c := range cases {
+		ref, err := ParseSecretRef(c.in)
+		if c.fail {
+			if err == nil {
+				t.Errorf("%q: expected an error, got %+v", c.in, ref)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("%q: %v", c.in, err)
+			continue
+		}
+		if ref != c.ref {
+			t.Errorf("%q: expected %+v, got %+v", c.in, c.ref, ref)
+		}
+		// references without a version get the default one
+		if s := ref.String(); s != c.in && s != c.in+"/versions/latest" {
+			t.Errorf("%q: unexpected string: %q", c.in, s)
+		}
+	}
+}
+
+func TestFileSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cloudfunc-secrets-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files := map[string]string{
+		"plain": "value",
+		"lf":    "value\n",
+		"crlf":  "value\r\n",
+		"multi": "line1\nline2\n\n",
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cases := []struct {
+		secret string
+		val    string
+		fail   bool
+	}{
+		{secret: "plain", val: "value"},
+		{secret: "lf", val: "value"},
+		{secret: "crlf", val: "value"},
+		{secret: "multi", val: "line1\nline2\n"},
+		{secret: "missing", fail: true},
+	}
+	r := FileSecrets(dir)
+	for _, c := range cases {
+		v, err := r.ResolveSecret(context.Background(), SecretRef{Project: "p", Secret: c.secret, Version: "1"})
+		if c.fail {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %q", c.secret, v)
+			}
+		} else if err != nil {
+			t.Errorf("%s: %v", c.secret, err)
+		} else if v != c.val {
+			t.Errorf("%s: expected %q, got %q", c.secret, c.val, v)
+		}
+	}
+}
+
+func TestResolveSecrets(t *testing.T) {
+	errDenied := errors.New("permission denied")
+	resolver := SecretResolverFunc(func(ctx context.Context, ref SecretRef) (string, error) {
+		if ref.Secret == "denied" {
+			return "", errDenied
+		}
+		return ref.Secret + "@" + ref.Version, nil
+	})
+	cases := []struct {
+		name string
+		env  map[string]string
+		exp  map[string]string
+		fail bool
+	}{
+		{
+			name: "resolved",
+			env: map[string]string{
+				"CF_TEST_A": "secret://projects/p/secrets/a",
+				"CF_TEST_B": "secret://projects/p/secrets/b/versions/2",
+				"CF_TEST_C": "plain",
+			},
+			exp: map[string]string{"CF_TEST_A": "a@latest", "CF_TEST_B": "b@2", "CF_TEST_C": "plain"},
+		},
+		{
+			name: "resolver error",
+			env:  map[string]string{"CF_TEST_A": "secret://projects/p/secrets/denied"},
+			exp:  map[string]string{"CF_TEST_A": "secret://projects/p/secrets/denied"},
+			fail: true,
+		},
+		{
+			name: "invalid reference",
+			env:  map[string]string{"CF_TEST_A": "secret://projects/p/secrets/a.b"},
+			exp:  map[string]string{"CF_TEST_A": "secret://projects/p/secrets/a.b"},
+			fail: true,
+		},
+	}
+	SetSecretResolver(resolver)
+	defer SetSecretResolver(nil)
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			for k, v := range c.env {
+				os.Setenv(k, v)
+				defer os.Unsetenv(k)
+			}
+			err := ResolveSecrets(context.Background())
+			if c.fail && err == nil {
+				t.Fatal("expected an error")
+			} else if !c.fail && err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range c.exp {
+				if got := os.Getenv(k); got != v {
+					t.Errorf("%s: expected %q, got %q", k, v, got)
+				}
+			}
+		})
+	}
+}